@@ -157,6 +157,7 @@ func (d *Daemon) init() {
 	d.NS.RegisterDeletionHandler(d.RunMgr)
 
 	d.Server = daemon.New(d.Apps, d.RunMgr, d.ClusterMgr, d.Secret, d.NS)
+	d.closeOnExit(d.Server)
 }
 
 func (d *Daemon) serve() {
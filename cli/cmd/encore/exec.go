@@ -1,24 +1,84 @@
 package main
 
 import (
+	"bufio"
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 
+	"encr.dev/cli/cmd/encore/cmdutil"
 	"encr.dev/cli/cmd/encore/root"
 	daemonpb "encr.dev/proto/encore/daemon"
 )
 
+var (
+	execDryRun      bool
+	execAllCommands bool
+	execAsUser      string
+	execKeepBinary  bool
+	execContext     map[string]string
+	execSecrets     map[string]string
+	execInteractive bool
+	execStandalone  bool
+	execCleanup     string
+	execCleanupArgs []string
+	execResumeID    string
+	execAttach      string
+	execCancel      string
+	execSince       uint64
+	execSessionID   string
+	execDetach      bool
+	execRace        bool
+	execMaxOutput   uint64
+	execKillOnMax   bool
+	execTTY         bool
+	execParallelism uint32
+	execEntrypoint  string
+	execTempDir     string
+	execTimezone    string
+	execLocale      string
+	execScriptApp   string
+	execRetries     uint32
+	execBackoff     uint32
+	execGrace       uint32
+	execEphemeral   bool
+	execLogLevel    string
+	execArtifacts   string
+	execLogAccess   bool
+	execVerbosity   = cmdutil.Oneof{
+		Value:     "normal",
+		Allowed:   []string{"quiet", "normal", "verbose"},
+		Flag:      "build-verbosity",
+		FlagShort: "", // no short flag
+		Desc:      "How much build output to show",
+		TypeDesc:  "string",
+	}
+)
+
 var execCmd = &cobra.Command{
-	Use:   "exec path/to/script [args...]",
+	Use:   "exec <path/to/script|command-name> [args...]",
 	Short: "Runs executable scripts against the local Encore app",
 	Run: func(cmd *cobra.Command, args []string) {
 		if len(args) == 0 {
 			args = []string{"."} // current directory
 		}
+		if execAttach != "" {
+			attachScript(execAttach, execSince)
+			return
+		} else if execCancel != "" {
+			cancelScript(execCancel)
+			return
+		} else if execKillOnMax && execMaxOutput == 0 {
+			fatal("--kill-on-max-output requires --max-output-bytes")
+		} else if execArtifacts != "" && execDetach {
+			fatal("--artifacts cannot be combined with --detach")
+		}
 		appRoot, wd := determineAppRoot()
 		execScript(appRoot, wd, args)
 	},
@@ -34,11 +94,28 @@ func execScript(appRoot, relWD string, args []string) {
 		cancel()
 	}()
 
-	commandRelPath := filepath.ToSlash(filepath.Join(relWD, args[0]))
+	// Command paths in a sibling app are relative to its root.
+	cmdDir := relWD
+	if execScriptApp != "" {
+		cmdDir = "."
+	}
+	commandRelPath := filepath.ToSlash(filepath.Join(cmdDir, args[0]))
+	var cleanupRelPath *string
+	if execCleanup != "" {
+		p := filepath.ToSlash(filepath.Join(cmdDir, execCleanup))
+		cleanupRelPath = &p
+	}
 	scriptArgs := args[1:]
 
-	daemon := setupDaemon(ctx)
-	stream, err := daemon.ExecScript(ctx, &daemonpb.ExecScriptRequest{
+	verbosity := daemonpb.ExecScriptRequest_BUILD_VERBOSITY_NORMAL
+	switch execVerbosity.Value {
+	case "quiet":
+		verbosity = daemonpb.ExecScriptRequest_BUILD_VERBOSITY_QUIET
+	case "verbose":
+		verbosity = daemonpb.ExecScriptRequest_BUILD_VERBOSITY_VERBOSE
+	}
+
+	req := &daemonpb.ExecScriptRequest{
 		AppRoot:        appRoot,
 		WorkingDir:     relWD,
 		CommandRelPath: commandRelPath,
@@ -46,9 +123,82 @@ func execScript(appRoot, relWD string, args []string) {
 		Environ:        os.Environ(),
 		TraceFile:      root.TraceFile,
 		Namespace:      nonZeroPtr(nsName),
-	})
-	if err != nil {
-		fatal(err)
+		DryRun:         execDryRun,
+		RunAllCommands: execAllCommands,
+		BuildVerbosity: verbosity,
+		RunAsUser:      nonZeroPtr(execAsUser),
+		KeepBinary:     execKeepBinary,
+		ContextValues:  execContext,
+
+		SecretOverrides: execSecrets,
+		Standalone:      execStandalone,
+
+		CleanupCommandRelPath: cleanupRelPath,
+		CleanupArgs:           execCleanupArgs,
+		ResumeId:              nonZeroPtr(execResumeID),
+		SessionId:             nonZeroPtr(execSessionID),
+		Detach:                execDetach,
+		Race:                  execRace,
+		MaxOutputBytes:        execMaxOutput,
+		KillOnMaxOutput:       execKillOnMax,
+		BuildParallelism:      execParallelism,
+		Entrypoint:            nonZeroPtr(execEntrypoint),
+		Timezone:              nonZeroPtr(execTimezone),
+		Locale:                nonZeroPtr(execLocale),
+		MaxRetries:            execRetries,
+		BackoffSeconds:        execBackoff,
+		ShutdownGraceSeconds:  execGrace,
+		EphemeralNamespace:    execEphemeral,
+		MinLogLevel:           nonZeroPtr(execLogLevel),
+		CollectArtifacts:      execArtifacts != "",
+		LogResourceAccess:     execLogAccess,
+	}
+	// Arguments without a path separator may name a command declared in encore.app.
+	// The daemon rejects names that are also the path of another directory.
+	if name := args[0]; !strings.ContainsAny(name, `/\`) && name != "." && name != ".." {
+		req.CommandName = &name
+	}
+	if execScriptApp != "" {
+		dir, err := filepath.Abs(execScriptApp)
+		if err != nil {
+			fatal(err)
+		}
+		req.ScriptAppRoot = &dir
+	}
+	if execTempDir != "" {
+		dir, err := filepath.Abs(execTempDir)
+		if err != nil {
+			fatal(err)
+		}
+		req.TempDir = &dir
+	}
+	if execTTY {
+		cols, rows, err := term.GetSize(int(os.Stdout.Fd()))
+		if err != nil {
+			fatal("--tty requires standard output to be a terminal")
+		}
+		req.Tty = &daemonpb.TerminalSize{Rows: uint32(rows), Cols: uint32(cols)}
+	}
+
+	daemon := setupDaemon(ctx)
+	var stream commandOutputStream
+	if execInteractive {
+		if execAllCommands {
+			fatal("--interactive cannot be combined with --all-commands")
+		} else if execDetach {
+			fatal("--interactive cannot be combined with --detach")
+		}
+		stream = startScriptSession(ctx, daemon, req)
+	} else {
+		var err error
+		stream, err = daemon.ExecScript(ctx, req)
+		if err != nil {
+			fatal(err)
+		}
+	}
+
+	if execArtifacts != "" {
+		stream = &artifactStream{commandOutputStream: stream, dir: execArtifacts}
 	}
 
 	clearTerminalExceptFirstLine()
@@ -56,6 +206,128 @@ func execScript(appRoot, relWD string, args []string) {
 	os.Exit(code)
 }
 
+// artifactStream is a commandOutputStream that saves the artifacts
+// sent by the daemon to files in dir, instead of returning them.
+type artifactStream struct {
+	commandOutputStream
+	dir string
+
+	cur *os.File // the file being written, if any
+}
+
+func (s *artifactStream) Recv() (*daemonpb.CommandMessage, error) {
+	for {
+		msg, err := s.commandOutputStream.Recv()
+		if err != nil {
+			return msg, err
+		}
+		artifact := msg.GetArtifact()
+		if artifact == nil {
+			return msg, nil
+		}
+		if err := s.save(artifact); err != nil {
+			fatal("save artifact: ", err)
+		}
+	}
+}
+
+// save writes the artifact chunk to its file in s.dir.
+func (s *artifactStream) save(a *daemonpb.CommandArtifact) error {
+	if s.cur == nil {
+		rel := filepath.FromSlash(a.Path)
+		if !filepath.IsLocal(rel) {
+			return fmt.Errorf("invalid artifact path %q", a.Path)
+		}
+		dst := filepath.Join(s.dir, rel)
+		if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return err
+		}
+		f, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(a.Mode).Perm())
+		if err != nil {
+			return err
+		}
+		s.cur = f
+	}
+	if _, err := s.cur.Write(a.Data); err != nil {
+		return err
+	}
+	if a.Eof {
+		err := s.cur.Close()
+		s.cur = nil
+		return err
+	}
+	return nil
+}
+
+// attachScript reattaches to the resumable script with the given resume id,
+// replaying its output from the given sequence number.
+func attachScript(resumeID string, since uint64) {
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-interrupt
+		cancel()
+	}()
+
+	daemon := setupDaemon(ctx)
+	stream, err := daemon.ExecScriptResume(ctx, &daemonpb.ExecScriptResumeRequest{
+		ResumeId: resumeID,
+		Since:    &daemonpb.ExecScriptResumeRequest_SinceSeq{SinceSeq: since},
+	})
+	if err != nil {
+		fatal(err)
+	}
+	var out commandOutputStream = stream
+	if execArtifacts != "" {
+		out = &artifactStream{commandOutputStream: stream, dir: execArtifacts}
+	}
+	code := streamCommandOutput(out, convertJSONLogs())
+	os.Exit(code)
+}
+
+// cancelScript cancels the running script started with the given resume id.
+func cancelScript(resumeID string) {
+	ctx := context.Background()
+	daemon := setupDaemon(ctx)
+	if _, err := daemon.ExecScriptCancel(ctx, &daemonpb.ExecScriptCancelRequest{ResumeId: resumeID}); err != nil {
+		fatal(err)
+	}
+	fmt.Fprintf(os.Stderr, "Canceled script %s.\n", resumeID)
+}
+
+// startScriptSession starts an interactive script session, sending each line
+// read from standard input to the script as a command until it's exhausted.
+func startScriptSession(ctx context.Context, daemon daemonpb.DaemonClient, req *daemonpb.ExecScriptRequest) daemonpb.Daemon_ExecScriptSessionClient {
+	stream, err := daemon.ExecScriptSession(ctx)
+	if err != nil {
+		fatal(err)
+	}
+	err = stream.Send(&daemonpb.ExecScriptSessionRequest{
+		Msg: &daemonpb.ExecScriptSessionRequest_Start{Start: req},
+	})
+	if err != nil {
+		fatal(err)
+	}
+
+	go func() {
+		sc := bufio.NewScanner(os.Stdin)
+		for sc.Scan() {
+			err := stream.Send(&daemonpb.ExecScriptSessionRequest{
+				Msg: &daemonpb.ExecScriptSessionRequest_Command{
+					Command: &daemonpb.ExecScriptCommand{Line: sc.Text()},
+				},
+			})
+			if err != nil {
+				return // the session has ended
+			}
+		}
+		_ = stream.CloseSend()
+	}()
+	return stream
+}
+
 var alphaCmd = &cobra.Command{
 	Use:    "alpha",
 	Short:  "Pre-release functionality in alpha stage",
@@ -68,5 +340,39 @@ func init() {
 
 func init() {
 	execCmd.Flags().StringVarP(&nsName, "namespace", "n", "", "Namespace to use (defaults to active namespace)")
+	execCmd.Flags().BoolVar(&execDryRun, "dry-run", false, "Print what would be executed without building or running the script")
+	execCmd.Flags().BoolVar(&execAllCommands, "all-commands", false, "Run every main package within a cmd directory in the app, sequentially")
+	execCmd.Flags().StringVar(&execAsUser, "as-user", "", "Run the script as the given OS user (Unix only)")
+	execCmd.Flags().BoolVar(&execKeepBinary, "keep-binary", false, "Keep the compiled script binary after it exits, and print its path")
+	execCmd.Flags().StringToStringVar(&execContext, "context", nil, "Context values to make available to the script via encore.ScriptContextValues(), as key=value pairs")
+	execCmd.Flags().StringToStringVar(&execSecrets, "secret", nil, "Secret values to use for this execution only, as name=value pairs")
+	execCmd.Flags().BoolVarP(&execInteractive, "interactive", "i", false, "Keep the script running and send it each line read from standard input as a command")
+	execCmd.Flags().Uint32Var(&execParallelism, "build-parallelism", 0, "Number of build commands to run in parallel (defaults to the number of CPUs)")
+	execCmd.Flags().StringVar(&execTempDir, "temp-dir", "", "Directory to stage the script's build in, instead of the system's temp dir (must allow executing files)")
+	execCmd.Flags().StringVar(&execTimezone, "timezone", "", "Time zone to run the script in, like Europe/Stockholm (sets TZ)")
+	execCmd.Flags().StringVar(&execLocale, "locale", "", "Locale to run the script in, like sv_SE.UTF-8 (sets LANG)")
+	execCmd.Flags().Uint32Var(&execRetries, "retries", 0, "Re-run the script up to this many times if it exits with a non-zero status (build failures aren't retried)")
+	execCmd.Flags().Uint32Var(&execBackoff, "retry-backoff", 1, "With --retries, seconds to wait before the first retry, doubled for each subsequent one")
+	execCmd.Flags().Uint32Var(&execGrace, "shutdown-grace", 0, "When canceled, seconds to give the script to exit after SIGTERM before killing it (0 kills it right away)")
+	execCmd.Flags().StringVar(&execArtifacts, "artifacts", "", "Save the files the script writes to $ENCORE_ARTIFACT_DIR, such as reports or exports, to this directory (for resumable scripts, only those sent while attached)")
+	execCmd.Flags().BoolVar(&execLogAccess, "log-resource-access", false, "Log every database query, Pub/Sub publish and cache operation the script performs (at debug level)")
+	execCmd.Flags().StringVar(&execLogLevel, "log-level", "", "Only show the script's structured log records at this level or above (trace, debug, info, warn or error)")
+	execCmd.Flags().BoolVar(&execRace, "race", false, "Build the script with the Go race detector enabled")
+	execCmd.Flags().Uint64Var(&execMaxOutput, "max-output-bytes", 0, "Truncate the script's output after this many bytes (0 means unlimited)")
+	execCmd.Flags().BoolVar(&execKillOnMax, "kill-on-max-output", false, "With --max-output-bytes, terminate the script when it exceeds the limit")
+	execCmd.Flags().BoolVar(&execTTY, "tty", false, "Run the script in a pseudo-terminal the size of this one, for scripts with rich terminal output")
+	execCmd.Flags().BoolVar(&execEphemeral, "ephemeral-namespace", false, "Run the script against a new, empty namespace that's deleted afterwards, leaving existing data untouched")
+	execCmd.Flags().StringVar(&execScriptApp, "script-app", "", "Run the script from the sibling Encore app in this directory (with command paths relative to its root), against the current app's namespace")
+	execCmd.Flags().StringVar(&execEntrypoint, "entrypoint", "", "Run the given exported function in the script's package, instead of requiring a main package")
+	execCmd.Flags().BoolVar(&execStandalone, "standalone", false, "Build only the script's package, skipping the full app build (the script cannot access Encore resources)")
+	execCmd.Flags().StringVar(&execCleanup, "cleanup", "", "Command to run after the script exits, whether or not it succeeded (e.g. to clean up resources it created)")
+	execCmd.Flags().StringArrayVar(&execCleanupArgs, "cleanup-arg", nil, "Argument to pass to the cleanup command (can be repeated)")
+	execCmd.Flags().StringVar(&execResumeID, "resume-id", "", "Keep the script running if disconnected, so its output can be resumed with --attach using this id")
+	execCmd.Flags().StringVar(&execAttach, "attach", "", "Reattach to the running script started with the given --resume-id, instead of starting a new one")
+	execCmd.Flags().StringVar(&execCancel, "cancel", "", "Cancel the running script started with the given --resume-id, instead of starting a new one")
+	execCmd.Flags().BoolVar(&execDetach, "detach", false, "Start the script in the background and exit, printing the id to --attach to it with")
+	execCmd.Flags().Uint64Var(&execSince, "since", 0, "With --attach, replay output starting from this sequence number")
+	execCmd.Flags().StringVar(&execSessionID, "session-id", "", "Session id to group related script runs under in logs and traces (generated if not set)")
+	execVerbosity.AddFlag(execCmd)
 	alphaCmd.AddCommand(execCmd)
 }
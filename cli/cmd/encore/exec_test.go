@@ -0,0 +1,106 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+func TestArtifactStreamSave(t *testing.T) {
+	tests := []struct {
+		name     string
+		chunks   []*daemonpb.CommandArtifact
+		wantPath string
+		wantData string
+		wantMode os.FileMode
+		wantErr  string
+	}{
+		{
+			name:     "single_chunk",
+			chunks:   []*daemonpb.CommandArtifact{{Path: "report.txt", Data: []byte("hello"), Mode: 0644, Eof: true}},
+			wantPath: "report.txt",
+			wantData: "hello",
+			wantMode: 0644,
+		},
+		{
+			name: "multiple_chunks",
+			chunks: []*daemonpb.CommandArtifact{
+				{Path: "big.bin", Data: []byte("hello "), Mode: 0644},
+				{Path: "big.bin", Data: []byte("world"), Mode: 0644},
+				{Path: "big.bin", Mode: 0644, Eof: true},
+			},
+			wantPath: "big.bin",
+			wantData: "hello world",
+			wantMode: 0644,
+		},
+		{
+			name:     "nested",
+			chunks:   []*daemonpb.CommandArtifact{{Path: "sub/dir/out.csv", Data: []byte("a,b"), Mode: 0600, Eof: true}},
+			wantPath: "sub/dir/out.csv",
+			wantData: "a,b",
+			wantMode: 0600,
+		},
+		{
+			name:     "executable",
+			chunks:   []*daemonpb.CommandArtifact{{Path: "run.sh", Data: []byte("#!/bin/sh"), Mode: 0755, Eof: true}},
+			wantPath: "run.sh",
+			wantData: "#!/bin/sh",
+			wantMode: 0755,
+		},
+		{
+			name:    "parent_dir",
+			chunks:  []*daemonpb.CommandArtifact{{Path: "../escape.txt", Data: []byte("x"), Mode: 0644, Eof: true}},
+			wantErr: `invalid artifact path "../escape.txt"`,
+		},
+		{
+			name:    "nested_parent_dir",
+			chunks:  []*daemonpb.CommandArtifact{{Path: "sub/../../escape.txt", Data: []byte("x"), Mode: 0644, Eof: true}},
+			wantErr: `invalid artifact path "sub/../../escape.txt"`,
+		},
+		{
+			name:    "absolute",
+			chunks:  []*daemonpb.CommandArtifact{{Path: "/etc/escape.txt", Data: []byte("x"), Mode: 0644, Eof: true}},
+			wantErr: `invalid artifact path "/etc/escape.txt"`,
+		},
+		{
+			name:    "empty",
+			chunks:  []*daemonpb.CommandArtifact{{Path: "", Data: []byte("x"), Mode: 0644, Eof: true}},
+			wantErr: `invalid artifact path ""`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := qt.New(t)
+			root := t.TempDir()
+			dir := filepath.Join(root, "artifacts")
+			s := &artifactStream{dir: dir}
+
+			var err error
+			for _, chunk := range test.chunks {
+				if err = s.save(chunk); err != nil {
+					break
+				}
+			}
+			if test.wantErr != "" {
+				c.Assert(err, qt.ErrorMatches, test.wantErr)
+				entries, _ := os.ReadDir(root)
+				c.Assert(entries, qt.HasLen, 0) // nothing was written
+				return
+			}
+			c.Assert(err, qt.IsNil)
+			c.Assert(s.cur, qt.IsNil)
+
+			path := filepath.Join(dir, filepath.FromSlash(test.wantPath))
+			data, err := os.ReadFile(path)
+			c.Assert(err, qt.IsNil)
+			c.Assert(string(data), qt.Equals, test.wantData)
+			fi, err := os.Stat(path)
+			c.Assert(err, qt.IsNil)
+			c.Assert(fi.Mode().Perm(), qt.Equals, test.wantMode)
+		})
+	}
+}
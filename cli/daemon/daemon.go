@@ -49,6 +49,13 @@ type Server struct {
 	mu      sync.Mutex
 	streams map[string]*streamLog // run id -> stream
 
+	execBuffers map[string]*replayBuffer // resume id -> output of resumable scripts
+
+	// ctx is canceled when the server is closed,
+	// stopping the scripts that outlive their requests.
+	ctx    context.Context
+	cancel context.CancelFunc
+
 	availableVerInit sync.Once
 	availableVer     atomic.Value // string
 
@@ -68,8 +75,11 @@ func New(appsMgr *apps.Manager, mgr *run.Manager, cm *sqldb.ClusterManager, sm *
 		ns:      ns,
 		streams: make(map[string]*streamLog),
 
+		execBuffers: make(map[string]*replayBuffer),
+
 		appDebouncers: make(map[*apps.Instance]*regenerateCodeDebouncer),
 	}
+	srv.ctx, srv.cancel = context.WithCancel(context.Background())
 
 	mgr.AddListener(srv)
 
@@ -82,6 +92,12 @@ func New(appsMgr *apps.Manager, mgr *run.Manager, cm *sqldb.ClusterManager, sm *
 	return srv
 }
 
+// Close stops the scripts running in the background, such as resumable scripts.
+func (s *Server) Close() error {
+	s.cancel()
+	return nil
+}
+
 // GenClient generates a client based on the app's API.
 func (s *Server) GenClient(ctx context.Context, params *daemonpb.GenClientRequest) (*daemonpb.GenClientResponse, error) {
 	var md *meta.Data
@@ -0,0 +1,80 @@
+package daemon
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+// artifactDirEnv is the environment variable holding the path to the directory
+// scripts write their artifacts to, when artifacts are collected.
+const artifactDirEnv = "ENCORE_ARTIFACT_DIR"
+
+// artifactChunkSize is the maximum size of the data in each artifact message,
+// well below gRPC's default maximum message size.
+const artifactChunkSize = 256 << 10
+
+// streamArtifacts sends the files in dir to stream as artifact messages,
+// and returns the number of files sent. Files that aren't regular files,
+// such as symlinks, are skipped.
+func streamArtifacts(stream commandStream, dir string) (n int, err error) {
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		} else if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if err := streamArtifact(stream, path, filepath.ToSlash(rel), uint32(info.Mode().Perm())); err != nil {
+			return err
+		}
+		n++
+		return nil
+	})
+	return n, err
+}
+
+// streamArtifact sends the file at path to stream, as consecutive artifact
+// messages with the given relative path and mode.
+func streamArtifact(stream commandStream, path, rel string, mode uint32) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	buf := make([]byte, artifactChunkSize)
+	for {
+		n, err := io.ReadFull(f, buf)
+		eof := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !eof {
+			return err
+		}
+		// Chunks that fill the buffer may be followed by an empty last chunk.
+		if err := stream.Send(&daemonpb.CommandMessage{Msg: &daemonpb.CommandMessage_Artifact{
+			Artifact: &daemonpb.CommandArtifact{
+				Path: rel,
+				Data: buf[:n:n],
+				Mode: mode,
+				Eof:  eof,
+			},
+		}}); err != nil {
+			return err
+		}
+		if eof {
+			return nil
+		}
+		buf = make([]byte, artifactChunkSize) // the sent chunk may be retained
+	}
+}
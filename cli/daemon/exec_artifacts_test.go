@@ -0,0 +1,69 @@
+package daemon
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestStreamArtifacts(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		size       int
+		mode       os.FileMode
+		wantChunks int
+	}{
+		{name: "empty", path: "empty.txt", size: 0, mode: 0644, wantChunks: 1},
+		{name: "small", path: "report.txt", size: 10, mode: 0644, wantChunks: 1},
+		{name: "exact_chunk", path: "exact.bin", size: artifactChunkSize, mode: 0644, wantChunks: 2},
+		{name: "multiple_chunks", path: "big.bin", size: 2*artifactChunkSize + 10, mode: 0644, wantChunks: 3},
+		{name: "nested", path: "sub/dir/nested.csv", size: 10, mode: 0644, wantChunks: 1},
+		{name: "executable", path: "run.sh", size: 10, mode: 0755, wantChunks: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := qt.New(t)
+			dir := t.TempDir()
+			data := bytes.Repeat([]byte("0123456789"), test.size/10+1)[:test.size]
+			path := filepath.Join(dir, filepath.FromSlash(test.path))
+			c.Assert(os.MkdirAll(filepath.Dir(path), 0755), qt.IsNil)
+			c.Assert(os.WriteFile(path, data, test.mode), qt.IsNil)
+			c.Assert(os.Chmod(path, test.mode), qt.IsNil)
+
+			stream := &recordingStream{}
+			n, err := streamArtifacts(stream, dir)
+			c.Assert(err, qt.IsNil)
+			c.Assert(n, qt.Equals, 1)
+			c.Assert(stream.msgs, qt.HasLen, test.wantChunks)
+
+			var got []byte
+			for i, msg := range stream.msgs {
+				a := msg.GetArtifact()
+				c.Assert(a.Path, qt.Equals, test.path)
+				c.Assert(os.FileMode(a.Mode), qt.Equals, test.mode)
+				c.Assert(a.Eof, qt.Equals, i == len(stream.msgs)-1)
+				c.Assert(len(a.Data) <= artifactChunkSize, qt.IsTrue)
+				got = append(got, a.Data...)
+			}
+			c.Assert(string(got), qt.Equals, string(data))
+		})
+	}
+}
+
+func TestStreamArtifactsSkipsSymlinks(t *testing.T) {
+	c := qt.New(t)
+	dir := t.TempDir()
+	c.Assert(os.WriteFile(filepath.Join(dir, "file.txt"), []byte("data"), 0644), qt.IsNil)
+	c.Assert(os.Symlink("file.txt", filepath.Join(dir, "link.txt")), qt.IsNil)
+
+	stream := &recordingStream{}
+	n, err := streamArtifacts(stream, dir)
+	c.Assert(err, qt.IsNil)
+	c.Assert(n, qt.Equals, 1)
+	c.Assert(stream.msgs, qt.HasLen, 1)
+	c.Assert(stream.msgs[0].GetArtifact().Path, qt.Equals, "file.txt")
+}
@@ -0,0 +1,286 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+const (
+	// replayCapacity is the maximum number of messages
+	// a replayBuffer retains for replaying.
+	replayCapacity = 10000
+
+	// replayRetention is how long the output of a finished
+	// resumable script is retained for replaying.
+	replayRetention = 5 * time.Minute
+)
+
+// replayBuffer is a commandStream that assigns sequence numbers to the messages
+// sent to it and retains the most recent ones, so that clients can attach to it
+// and replay the output from a given point, for example after a disconnect.
+type replayBuffer struct {
+	mu      sync.Mutex
+	msgs    []replayMsg   // the retained messages; the last replayCapacity are used
+	nextSeq uint64        // the sequence number of the next message
+	changed chan struct{} // closed (and replaced) whenever msgs or done changes
+	done    bool          // whether no more messages will be sent
+
+	// cancel cancels the script producing the messages.
+	cancel context.CancelFunc
+
+	// Artifact messages are too large to retain, so they're only sent to the
+	// streams replaying the messages at the time, starting with the first artifact
+	// file they receive in full.
+	live      map[*lockedStream]int // stream -> the index of its first artifact
+	artifacts int                   // the index of the artifact being sent
+	midFile   bool                  // whether the artifact has been partially sent
+}
+
+// lockedStream is a commandStream that can be sent to concurrently.
+type lockedStream struct {
+	mu     sync.Mutex
+	stream commandStream
+}
+
+func (s *lockedStream) Send(msg *daemonpb.CommandMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stream.Send(msg)
+}
+
+type replayMsg struct {
+	seq  uint64
+	time time.Time
+	msg  *daemonpb.CommandMessage
+}
+
+func newReplayBuffer(cancel context.CancelFunc) *replayBuffer {
+	return &replayBuffer{changed: make(chan struct{}), cancel: cancel, live: make(map[*lockedStream]int)}
+}
+
+// Send implements commandStream.
+func (b *replayBuffer) Send(msg *daemonpb.CommandMessage) error {
+	if msg.GetArtifact() != nil {
+		b.sendLive(msg)
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	seq := b.nextSeq
+	b.nextSeq++
+	if out := msg.GetOutput(); out != nil {
+		out.Seq = seq
+	}
+	b.msgs = append(b.msgs, replayMsg{seq: seq, time: time.Now(), msg: msg})
+
+	// Only trim occasionally, to avoid copying the buffer on every message.
+	if len(b.msgs) >= 2*replayCapacity {
+		b.msgs = slices.Clone(b.msgs[len(b.msgs)-replayCapacity:])
+	}
+	b.notify()
+	return nil
+}
+
+// sendLive sends the artifact message to the streams currently replaying the messages.
+// Errors are ignored, since they also end the replay sending to the failing stream.
+func (b *replayBuffer) sendLive(msg *daemonpb.CommandMessage) {
+	b.mu.Lock()
+	var live []*lockedStream
+	for s, first := range b.live {
+		if first <= b.artifacts {
+			live = append(live, s)
+		}
+	}
+	b.midFile = !msg.GetArtifact().Eof
+	if !b.midFile {
+		b.artifacts++
+	}
+	b.mu.Unlock()
+
+	for _, s := range live {
+		_ = s.Send(msg)
+	}
+}
+
+// close marks the buffer as done, meaning no more messages will be sent.
+func (b *replayBuffer) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.done = true
+	b.notify()
+}
+
+// notify wakes up any waiting readers. b.mu must be held.
+func (b *replayBuffer) notify() {
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+// retained returns the retained messages. b.mu must be held.
+func (b *replayBuffer) retained() []replayMsg {
+	return b.msgs[max(0, len(b.msgs)-replayCapacity):]
+}
+
+// replay sends the retained messages from the message with sequence number since
+// (or, if sinceTime is non-zero, from the first message produced at or after it)
+// to out, followed by any new messages until the buffer is done or ctx is canceled.
+// Artifact messages aren't retained, so only those sent while replaying are sent to out.
+func (b *replayBuffer) replay(ctx context.Context, out commandStream, since uint64, sinceTime time.Time) error {
+	stream := &lockedStream{stream: out}
+	b.mu.Lock()
+	first := b.artifacts
+	if b.midFile {
+		first++
+	}
+	b.live[stream] = first
+	defer func() {
+		b.mu.Lock()
+		delete(b.live, stream)
+		b.mu.Unlock()
+	}()
+	if first > 0 {
+		b.mu.Unlock()
+		_ = stream.Send(&daemonpb.CommandMessage{Msg: &daemonpb.CommandMessage_Output{
+			Output: &daemonpb.CommandOutput{Stderr: []byte(fmt.Sprintf("[%d earlier artifacts are not available, since artifacts are only sent to attached clients]\n", first))},
+		}})
+		b.mu.Lock()
+	}
+
+	msgs := b.retained()
+	if !sinceTime.IsZero() {
+		since = b.nextSeq
+		for _, m := range msgs {
+			if !m.time.Before(sinceTime) {
+				since = m.seq
+				break
+			}
+		}
+	}
+	if len(msgs) > 0 && since < msgs[0].seq {
+		dropped := msgs[0].seq - since
+		b.mu.Unlock()
+		_ = stream.Send(&daemonpb.CommandMessage{Msg: &daemonpb.CommandMessage_Output{
+			Output: &daemonpb.CommandOutput{Stderr: []byte(fmt.Sprintf("[%d earlier output messages are no longer available]\n", dropped))},
+		}})
+		b.mu.Lock()
+	}
+
+	next := since
+	for {
+		var pending []replayMsg
+		for _, m := range b.retained() {
+			if m.seq >= next {
+				pending = append(pending, m)
+			}
+		}
+		done, changed := b.done, b.changed
+		b.mu.Unlock()
+
+		for _, m := range pending {
+			if err := stream.Send(m.msg); err != nil {
+				return err
+			}
+			next = m.seq + 1
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		b.mu.Lock()
+	}
+}
+
+// execResumable executes a resumable script, which keeps running if the client
+// disconnects, and whose output can be replayed using ExecScriptResume.
+//
+// The script runs until it exits, is canceled with ExecScriptCancel or the server
+// is closed, regardless of any attached clients, and its output (including the
+// final exit message) stays available for replayRetention after that.
+// If the request is detached, it returns as soon as the script has been started,
+// reporting its resume id instead of streaming its output.
+func (s *Server) execResumable(req *daemonpb.ExecScriptRequest, stream daemonpb.Daemon_ExecScriptServer) error {
+	id := *req.ResumeId
+	ctx, cancel := context.WithCancel(s.ctx)
+	buf := newReplayBuffer(cancel)
+	s.mu.Lock()
+	if _, ok := s.execBuffers[id]; ok {
+		s.mu.Unlock()
+		cancel()
+		return status.Errorf(codes.AlreadyExists, "a script with resume id %q already exists", id)
+	}
+	s.execBuffers[id] = buf
+	s.mu.Unlock()
+
+	go func() {
+		defer func() {
+			cancel()
+			buf.close()
+			time.AfterFunc(replayRetention, func() { s.expireExecBuffer(id) })
+		}()
+
+		// Run the script independently of the client's stream,
+		// so that it survives the client disconnecting.
+		_ = s.execScript(ctx, req, buf, nil)
+	}()
+	if req.Detach {
+		_ = stream.Send(&daemonpb.CommandMessage{Msg: &daemonpb.CommandMessage_Output{
+			Output: &daemonpb.CommandOutput{Stderr: []byte(fmt.Sprintf(
+				"Started script in the background with resume id %s.\nAttach to it with: encore alpha exec --attach=%s\n"+
+					"Cancel it with: encore alpha exec --cancel=%s\n", id, id, id))},
+		}})
+		streamExit(stream, 0)
+		return nil
+	}
+	return buf.replay(stream.Context(), stream, 0, time.Time{})
+}
+
+// expireExecBuffer stops retaining the output of the resumable script with the given id.
+func (s *Server) expireExecBuffer(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.execBuffers, id)
+}
+
+// ExecScriptResume reattaches to a resumable script, replaying its buffered output.
+func (s *Server) ExecScriptResume(req *daemonpb.ExecScriptResumeRequest, stream daemonpb.Daemon_ExecScriptResumeServer) error {
+	s.mu.Lock()
+	buf, ok := s.execBuffers[req.ResumeId]
+	s.mu.Unlock()
+	if !ok {
+		return status.Errorf(codes.NotFound, "no script with resume id %q", req.ResumeId)
+	}
+
+	var sinceTime time.Time
+	if t := req.GetSinceTime(); t != nil {
+		sinceTime = t.AsTime()
+	}
+	return buf.replay(stream.Context(), stream, req.GetSinceSeq(), sinceTime)
+}
+
+// ExecScriptCancel cancels a resumable script. Its output stays available for replaying.
+func (s *Server) ExecScriptCancel(ctx context.Context, req *daemonpb.ExecScriptCancelRequest) (*emptypb.Empty, error) {
+	s.mu.Lock()
+	buf, ok := s.execBuffers[req.ResumeId]
+	s.mu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no script with resume id %q", req.ResumeId)
+	}
+	buf.cancel()
+	return new(emptypb.Empty), nil
+}
@@ -0,0 +1,252 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+// recordingStream is a commandStream that records the messages sent to it.
+type recordingStream struct {
+	grpc.ServerStream
+	msgs []*daemonpb.CommandMessage
+}
+
+func (s *recordingStream) Send(msg *daemonpb.CommandMessage) error {
+	s.msgs = append(s.msgs, msg)
+	return nil
+}
+
+func (s *recordingStream) Context() context.Context {
+	return context.Background()
+}
+
+// output returns the stdout and stderr of the recorded output messages, one per message.
+func (s *recordingStream) output() []string {
+	var out []string
+	for _, msg := range s.msgs {
+		if o := msg.GetOutput(); o != nil {
+			out = append(out, string(o.Stdout)+string(o.Stderr))
+		}
+	}
+	return out
+}
+
+func sendOutput(buf *replayBuffer, lines ...string) {
+	for _, line := range lines {
+		_ = buf.Send(&daemonpb.CommandMessage{Msg: &daemonpb.CommandMessage_Output{
+			Output: &daemonpb.CommandOutput{Stdout: []byte(line)},
+		}})
+	}
+}
+
+func lines(from, to int) []string {
+	var out []string
+	for i := from; i < to; i++ {
+		out = append(out, fmt.Sprintf("line %d", i))
+	}
+	return out
+}
+
+func TestReplayBuffer(t *testing.T) {
+	tests := []struct {
+		name  string
+		sent  int    // the number of messages sent
+		since uint64 // the sequence number to replay from
+		want  []string
+	}{
+		{
+			name: "all",
+			sent: 3,
+			want: lines(0, 3),
+		},
+		{
+			name:  "since",
+			sent:  5,
+			since: 3,
+			want:  lines(3, 5),
+		},
+		{
+			name:  "since_last",
+			sent:  5,
+			since: 4,
+			want:  lines(4, 5),
+		},
+		{
+			name:  "since_future",
+			sent:  5,
+			since: 10,
+			want:  nil,
+		},
+		{
+			name: "at_capacity",
+			sent: replayCapacity,
+			want: lines(0, replayCapacity),
+		},
+		{
+			name: "evicted",
+			sent: replayCapacity + 5,
+			want: append([]string{"[5 earlier output messages are no longer available]\n"}, lines(5, replayCapacity+5)...),
+		},
+		{
+			name:  "evicted_resume",
+			sent:  replayCapacity + 5,
+			since: 3,
+			want:  append([]string{"[2 earlier output messages are no longer available]\n"}, lines(5, replayCapacity+5)...),
+		},
+		{
+			name:  "evicted_trimmed",
+			sent:  2*replayCapacity + 1,
+			since: replayCapacity + 1,
+			want:  lines(replayCapacity+1, 2*replayCapacity+1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := qt.New(t)
+			buf := newReplayBuffer(func() {})
+			sendOutput(buf, lines(0, test.sent)...)
+			buf.close()
+
+			stream := &recordingStream{}
+			err := buf.replay(context.Background(), stream, test.since, time.Time{})
+			c.Assert(err, qt.IsNil)
+			c.Assert(stream.output(), qt.DeepEquals, test.want)
+		})
+	}
+}
+
+func TestReplayBufferSequence(t *testing.T) {
+	c := qt.New(t)
+	buf := newReplayBuffer(func() {})
+	sendOutput(buf, lines(0, 3)...)
+	buf.close()
+
+	stream := &recordingStream{}
+	c.Assert(buf.replay(context.Background(), stream, 0, time.Time{}), qt.IsNil)
+	for i, msg := range stream.msgs {
+		c.Assert(msg.GetOutput().Seq, qt.Equals, uint64(i))
+	}
+}
+
+func TestReplayBufferSinceTime(t *testing.T) {
+	c := qt.New(t)
+	buf := newReplayBuffer(func() {})
+	sendOutput(buf, lines(0, 3)...)
+	buf.close()
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i := range buf.msgs {
+		buf.msgs[i].time = start.Add(time.Duration(i) * time.Minute)
+	}
+
+	stream := &recordingStream{}
+	c.Assert(buf.replay(context.Background(), stream, 0, start.Add(30*time.Second)), qt.IsNil)
+	c.Assert(stream.output(), qt.DeepEquals, lines(1, 3))
+
+	// Times after the last message replay nothing.
+	stream = &recordingStream{}
+	c.Assert(buf.replay(context.Background(), stream, 0, start.Add(time.Hour)), qt.IsNil)
+	c.Assert(stream.output(), qt.HasLen, 0)
+}
+
+func TestReplayBufferLive(t *testing.T) {
+	c := qt.New(t)
+	buf := newReplayBuffer(func() {})
+	sendOutput(buf, "before")
+
+	stream := &recordingStream{}
+	done := make(chan error, 1)
+	go func() { done <- buf.replay(context.Background(), stream, 0, time.Time{}) }()
+
+	sendOutput(buf, "after")
+	buf.close()
+	c.Assert(<-done, qt.IsNil)
+	c.Assert(stream.output(), qt.DeepEquals, []string{"before", "after"})
+}
+
+func sendArtifact(buf *replayBuffer, path string, eof bool) {
+	_ = buf.Send(&daemonpb.CommandMessage{Msg: &daemonpb.CommandMessage_Artifact{
+		Artifact: &daemonpb.CommandArtifact{Path: path, Eof: eof},
+	}})
+}
+
+func TestReplayBufferArtifacts(t *testing.T) {
+	c := qt.New(t)
+	buf := newReplayBuffer(func() {})
+	sendOutput(buf, "line")
+	sendArtifact(buf, "a", true)
+	sendArtifact(buf, "b", false) // attaching mid-file skips the rest of it
+
+	stream := &recordingStream{}
+	done := make(chan error, 1)
+	go func() { done <- buf.replay(context.Background(), stream, 0, time.Time{}) }()
+	for attached := false; !attached; {
+		buf.mu.Lock()
+		attached = len(buf.live) == 1
+		buf.mu.Unlock()
+	}
+
+	sendArtifact(buf, "b", true)
+	sendArtifact(buf, "c", false)
+	sendArtifact(buf, "c", true)
+	buf.close()
+	c.Assert(<-done, qt.IsNil)
+
+	var artifacts []string
+	for _, msg := range stream.msgs {
+		if a := msg.GetArtifact(); a != nil {
+			artifacts = append(artifacts, fmt.Sprintf("%s:%v", a.Path, a.Eof))
+		}
+	}
+	c.Assert(artifacts, qt.DeepEquals, []string{"c:false", "c:true"})
+	c.Assert(stream.output(), qt.DeepEquals, []string{
+		"[2 earlier artifacts are not available, since artifacts are only sent to attached clients]\n",
+		"line",
+	})
+	c.Assert(buf.msgs, qt.HasLen, 1) // artifacts aren't retained
+}
+
+func TestReplayBufferCanceled(t *testing.T) {
+	c := qt.New(t)
+	buf := newReplayBuffer(func() {})
+	sendOutput(buf, "line")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := buf.replay(ctx, &recordingStream{}, 0, time.Time{})
+	c.Assert(err, qt.ErrorIs, context.Canceled)
+}
+
+func TestExecBufferRetention(t *testing.T) {
+	c := qt.New(t)
+	canceled := false
+	buf := newReplayBuffer(func() { canceled = true })
+	sendOutput(buf, "line")
+	buf.close()
+	s := &Server{execBuffers: map[string]*replayBuffer{"id": buf}}
+
+	// The output of finished scripts can be replayed until it expires.
+	stream := &recordingStream{}
+	c.Assert(s.ExecScriptResume(&daemonpb.ExecScriptResumeRequest{ResumeId: "id"}, stream), qt.IsNil)
+	c.Assert(stream.output(), qt.DeepEquals, []string{"line"})
+
+	_, err := s.ExecScriptCancel(context.Background(), &daemonpb.ExecScriptCancelRequest{ResumeId: "id"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(canceled, qt.IsTrue)
+
+	s.expireExecBuffer("id")
+	err = s.ExecScriptResume(&daemonpb.ExecScriptResumeRequest{ResumeId: "id"}, &recordingStream{})
+	c.Assert(status.Code(err), qt.Equals, codes.NotFound)
+	_, err = s.ExecScriptCancel(context.Background(), &daemonpb.ExecScriptCancelRequest{ResumeId: "id"})
+	c.Assert(status.Code(err), qt.Equals, codes.NotFound)
+}
@@ -1,23 +1,106 @@
 package daemon
 
 import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/rs/xid"
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"golang.org/x/mod/modfile"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	"encr.dev/cli/daemon/apps"
+	"encr.dev/cli/daemon/namespace"
 	"encr.dev/cli/daemon/run"
 	"encr.dev/internal/optracker"
+	"encr.dev/pkg/appfile"
+	"encr.dev/pkg/option"
 	"encr.dev/pkg/paths"
+	"encr.dev/pkg/xos"
 	daemonpb "encr.dev/proto/encore/daemon"
 )
 
 // ExecScript executes a one-off script.
 func (s *Server) ExecScript(req *daemonpb.ExecScriptRequest, stream daemonpb.Daemon_ExecScriptServer) error {
-	ctx := stream.Context()
+	if req.Detach && req.ResumeId == nil {
+		id := run.GenID()
+		req.ResumeId = &id
+	}
+	if req.ResumeId != nil {
+		return s.execResumable(req, stream)
+	}
+	return s.execScript(stream.Context(), req, stream, nil)
+}
+
+// ExecScriptSession executes a script interactively, forwarding
+// the commands sent by the client to the script's standard input.
+func (s *Server) ExecScriptSession(stream daemonpb.Daemon_ExecScriptSessionServer) error {
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	req := msg.GetStart()
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "the first message in a session must start the script")
+	} else if req.RunAllCommands {
+		return status.Error(codes.InvalidArgument, "interactive sessions cannot run all commands")
+	} else if req.ResumeId != nil || req.Detach {
+		return status.Error(codes.InvalidArgument, "interactive sessions cannot be resumable")
+	}
+
+	stdin, stdinW := io.Pipe()
+	defer func() { _ = stdin.Close() }()
+	go forwardSessionCommands(stream, stdinW)
+	return s.execScript(stream.Context(), req, stream, stdin)
+}
+
+// forwardSessionCommands writes the commands received on stream to w, one per line,
+// until the client closes its side of the stream.
+func forwardSessionCommands(stream daemonpb.Daemon_ExecScriptSessionServer, w *io.PipeWriter) {
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			_ = w.Close()
+			return
+		} else if err != nil {
+			_ = w.CloseWithError(err)
+			return
+		}
+
+		cmd := msg.GetCommand()
+		if cmd == nil {
+			_ = w.CloseWithError(errors.New("unexpected message in script session"))
+			return
+		} else if strings.ContainsAny(cmd.Line, "\r\n") {
+			_ = w.CloseWithError(errors.New("script session commands must not contain newlines"))
+			return
+		}
+		if _, err := io.WriteString(w, cmd.Line+"\n"); err != nil {
+			return
+		}
+	}
+}
+
+// execScript executes a script, streaming its output to stream.
+// If stdin is non-nil it's forwarded to the script's standard input.
+func (s *Server) execScript(streamCtx context.Context, req *daemonpb.ExecScriptRequest, stream commandStream, stdin io.Reader) error {
 	slog := &streamLog{stream: stream, buffered: true}
 	stderr := slog.Stderr(false)
 	sendErr := func(err error) {
@@ -33,7 +116,7 @@ func (s *Server) ExecScript(req *daemonpb.ExecScriptRequest, stream daemonpb.Dae
 		streamExit(stream, 1)
 	}
 
-	ctx, tracer, err := s.beginTracing(ctx, req.AppRoot, req.WorkingDir, req.TraceFile)
+	ctx, tracer, err := s.beginTracing(streamCtx, req.AppRoot, req.WorkingDir, req.TraceFile)
 	if err != nil {
 		sendErr(err)
 		return nil
@@ -46,7 +129,32 @@ func (s *Server) ExecScript(req *daemonpb.ExecScriptRequest, stream daemonpb.Dae
 		return nil
 	}
 
-	modPath := filepath.Join(app.Root(), "go.mod")
+	if req.EphemeralNamespace && req.Namespace != nil {
+		sendErr(errors.New("an ephemeral namespace cannot be combined with a namespace"))
+		return nil
+	}
+	ns, err := s.namespaceOrActive(ctx, app, req.Namespace)
+	if err != nil {
+		sendErr(err)
+		return nil
+	}
+
+	// The script may be defined in a sibling app, in which case it's built
+	// and run from that app, but still against this app's namespace.
+	scriptApp, workingDir := app, req.WorkingDir
+	if root := req.GetScriptAppRoot(); req.ScriptAppRoot != nil {
+		if err := checkScriptApp(root); err != nil {
+			sendErr(err)
+			return nil
+		}
+		if scriptApp, err = s.apps.Track(root); err != nil {
+			sendErr(err)
+			return nil
+		}
+		workingDir = "."
+	}
+
+	modPath := filepath.Join(scriptApp.Root(), "go.mod")
 	modData, err := os.ReadFile(modPath)
 	if err != nil {
 		sendErr(err)
@@ -58,16 +166,106 @@ func (s *Server) ExecScript(req *daemonpb.ExecScriptRequest, stream daemonpb.Dae
 		return nil
 	}
 
-	ns, err := s.namespaceOrActive(ctx, app, req.Namespace)
+	// Names of commands declared by the app resolve to their paths.
+	// A name that's also the path of another directory is ambiguous.
+	commandRelPath := req.CommandRelPath
+	if name := req.GetCommandName(); name != "" {
+		relPath, ok, err := namedCommandPath(scriptApp.Root(), name)
+		if err != nil {
+			sendErr(err)
+			return nil
+		} else if ok {
+			dir := filepath.Join(scriptApp.Root(), filepath.FromSlash(commandRelPath))
+			cmdDir := filepath.Join(scriptApp.Root(), filepath.FromSlash(relPath))
+			if fi, err := os.Stat(dir); err == nil && fi.IsDir() && dir != cmdDir {
+				sendErr(fmt.Errorf("%q is ambiguous: it names both the command %s declared in %s and the directory %s; "+
+					"run either by its path instead", name, relPath, appfile.Name, commandRelPath))
+				return nil
+			}
+			commandRelPath = relPath
+		}
+	}
+	commandPkg, err := resolveCommandPkg(scriptApp.Root(), mod.Module.Mod.Path, commandRelPath)
 	if err != nil {
 		sendErr(err)
 		return nil
 	}
+	if fn := req.GetEntrypoint(); req.Entrypoint != nil {
+		if !token.IsIdentifier(fn) || !token.IsExported(fn) {
+			sendErr(fmt.Errorf("invalid entrypoint %q: must be the name of an exported function", fn))
+			return nil
+		} else if req.RunAllCommands {
+			sendErr(errors.New("an entrypoint cannot be combined with running all commands"))
+			return nil
+		}
+	}
+	minLogLevel := zerolog.NoLevel
+	if lvl := req.GetMinLogLevel(); req.MinLogLevel != nil {
+		if minLogLevel, err = parseLogLevel(lvl); err != nil {
+			sendErr(err)
+			return nil
+		} else if req.LogResourceAccess && minLogLevel > zerolog.DebugLevel {
+			sendErr(fmt.Errorf("resource access is logged at the debug level, which log level %s hides", lvl))
+			return nil
+		}
+	}
+	if dir := req.GetTempDir(); req.TempDir != nil && !filepath.IsAbs(dir) {
+		sendErr(fmt.Errorf("invalid temp dir %q: must be an absolute path", dir))
+		return nil
+	}
+	commandPkgs := []paths.Pkg{commandPkg}
+	if req.RunAllCommands {
+		commandPkgs, err = findCommandPkgs(scriptApp.Root(), mod.Module.Mod.Path)
+		if err != nil {
+			sendErr(err)
+			return nil
+		} else if len(commandPkgs) == 0 {
+			sendErr(errors.New("no main packages found in any cmd directory"))
+			return nil
+		}
+	}
+
+	if req.DryRun {
+		for _, pkg := range commandPkgs {
+			writeExecPlan(stderr, req, pkg, ns.Name)
+		}
+		streamExit(stream, 0)
+		return nil
+	}
 
-	commandPkg := paths.Pkg(mod.Module.Mod.Path).JoinSlash(paths.RelSlash(req.CommandRelPath))
+	if req.EphemeralNamespace {
+		// The active namespace is resolved above, so the new one isn't made active.
+		ns, err = s.ns.Create(ctx, app, namespace.Name("exec-"+xid.New().String()))
+		if err != nil {
+			sendErr(fmt.Errorf("create ephemeral namespace: %w", err))
+			return nil
+		}
+		defer s.deleteEphemeralNamespace(app, ns, stderr)
+		fmt.Fprintf(stderr, "Using ephemeral namespace %s\n", ns.Name)
+	}
+
+	var artifactDir string
+	if req.CollectArtifacts {
+		artifactDir, err = os.MkdirTemp(req.GetTempDir(), "encore-artifacts")
+		if err != nil {
+			sendErr(fmt.Errorf("create artifact dir: %w", err))
+			return nil
+		}
+		defer func() { _ = os.RemoveAll(artifactDir) }()
+		// The dir is only accessible to its owner, so it must belong to the script's user.
+		if usr := req.GetRunAsUser(); req.RunAsUser != nil {
+			if err := xos.ChownToUser(artifactDir, usr); err != nil {
+				sendErr(fmt.Errorf("give user %s the artifact dir: %w", usr, err))
+				return nil
+			}
+		}
+	}
 
-	ops := optracker.New(stderr, stream)
-	defer ops.AllDone() // Kill the tracker when we exit this function
+	sessionID := req.GetSessionId()
+	if sessionID == "" {
+		sessionID = run.GenID()
+		fmt.Fprintf(stderr, "Using session id %s (group later runs with it using --session-id)\n", sessionID)
+	}
 
 	testResults := make(chan error, 1)
 	defer func() {
@@ -79,26 +277,635 @@ func (s *Server) ExecScript(req *daemonpb.ExecScriptRequest, stream daemonpb.Dae
 			default:
 				err = fmt.Errorf("%v", recovered)
 			}
-			log.Err(err).Msg("panic during script execution")
+			log.Err(err).Str("session_id", sessionID).Msg("panic during script execution")
 			testResults <- fmt.Errorf("panic occured within Encore during script execution: %v\n", recovered)
 		}
 	}()
 
-	p := run.ExecScriptParams{
-		App:        app,
-		NS:         ns,
-		WorkingDir: req.WorkingDir,
-		Environ:    req.Environ,
-		MainPkg:    commandPkg,
-		ScriptArgs: req.ScriptArgs,
-		Stdout:     slog.Stdout(false),
-		Stderr:     slog.Stderr(false),
-		OpTracker:  ops,
-	}
-	if err := s.mgr.ExecScript(stream.Context(), p); err != nil {
-		sendErr(err)
+	verbosity := run.BuildNormal
+	switch req.BuildVerbosity {
+	case daemonpb.ExecScriptRequest_BUILD_VERBOSITY_QUIET:
+		verbosity = run.BuildQuiet
+	case daemonpb.ExecScriptRequest_BUILD_VERBOSITY_VERBOSE:
+		verbosity = run.BuildVerbose
+	}
+
+	// execOne executes the command in mainPkg with the given args.
+	// If cleanup is true it's executed as the cleanup command,
+	// with its output prefixed to tell it apart from the script's.
+	execOne := func(mainPkg paths.Pkg, args []string, cleanup bool) (*run.ExecScriptResult, error) {
+		// Only report build progress at normal verbosity: in quiet mode it's not wanted,
+		// and in verbose mode it would be interleaved with the Go build output.
+		var ops *optracker.OpTracker
+		if verbosity == run.BuildNormal {
+			ops = optracker.New(stderr, stream)
+			defer ops.AllDone() // Kill the tracker when we're done
+		}
+
+		p := run.ExecScriptParams{
+			App:        scriptApp,
+			NS:         ns,
+			WorkingDir: workingDir,
+			Environ:    req.Environ,
+			MainPkg:    mainPkg,
+			ScriptArgs: args,
+			Stdout:     slog.Stdout(false),
+			Stderr:     slog.Stderr(false),
+			Stdin:      stdin,
+			OpTracker:  ops,
+
+			BuildVerbosity: verbosity,
+			RunAsUser:      option.FromPointer(req.RunAsUser),
+			KeepBinary:     req.KeepBinary,
+			ContextValues:  req.ContextValues,
+
+			SecretOverrides: req.SecretOverrides,
+			Standalone:      req.Standalone,
+			SessionID:       sessionID,
+			Race:            req.Race,
+
+			BuildParallelism: int(req.BuildParallelism),
+			Entrypoint:       option.FromPointer(req.Entrypoint),
+			TempDir:          option.FromPointer(req.TempDir),
+			Timezone:         option.FromPointer(req.Timezone),
+			Locale:           option.FromPointer(req.Locale),
+			MaxRetries:       int(req.MaxRetries),
+			RetryBackoff:     time.Duration(req.BackoffSeconds) * time.Second,
+			ShutdownGrace:    time.Duration(req.ShutdownGraceSeconds) * time.Second,
+
+			LogResourceAccess: req.LogResourceAccess,
+		}
+		if tty := req.GetTty(); tty != nil {
+			p.TTY = option.Some(run.TerminalSize{Rows: uint16(tty.Rows), Cols: uint16(tty.Cols)})
+		}
+		if artifactDir != "" {
+			p.Environ = append(slices.Clip(p.Environ), artifactDirEnv+"="+artifactDir)
+		}
+		if cleanup {
+			p.Stdout = &prefixWriter{w: p.Stdout, prefix: []byte("[cleanup] ")}
+			p.Stderr = &prefixWriter{w: p.Stderr, prefix: []byte("[cleanup] ")}
+			p.Stdin = nil
+			p.KeepBinary = false
+			p.TTY = option.None[run.TerminalSize]()
+			p.Entrypoint = option.None[string]()
+			p.MaxRetries = 0
+		}
+
+		ctx := streamCtx
+		if req.MaxOutputBytes > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(streamCtx)
+			defer cancel()
+			limiter := &outputLimiter{max: req.MaxOutputBytes, marker: p.Stderr}
+			if req.KillOnMaxOutput {
+				limiter.onExceed = cancel
+			}
+			p.Stdout, p.Stderr = limiter.wrap(p.Stdout), limiter.wrap(p.Stderr)
+		}
+		if minLogLevel != zerolog.NoLevel {
+			stdoutFilter, stderrFilter := &logLevelFilter{w: p.Stdout, min: minLogLevel}, &logLevelFilter{w: p.Stderr, min: minLogLevel}
+			defer stdoutFilter.Flush()
+			defer stderrFilter.Flush()
+			p.Stdout, p.Stderr = stdoutFilter, stderrFilter
+		}
+		log.Info().Str("session_id", sessionID).Str("pkg", mainPkg.String()).Bool("cleanup", cleanup).Msg("executing script")
+		res, err := s.mgr.ExecScript(ctx, p)
+		if err != nil {
+			log.Info().Err(err).Str("session_id", sessionID).Str("pkg", mainPkg.String()).Msg("script failed")
+		}
+		return res, err
+	}
+
+	// runCleanup runs the cleanup command, if any, reporting whether it failed.
+	runCleanup := func() (ok bool) {
+		if req.CleanupCommandRelPath == nil {
+			return true
+		}
+		cleanupPkg, err := resolveCommandPkg(scriptApp.Root(), mod.Module.Mod.Path, *req.CleanupCommandRelPath)
+		if err != nil {
+			fmt.Fprintf(stderr, "\n=== CLEANUP %s\n%v\n", *req.CleanupCommandRelPath, err)
+			return false
+		}
+		fmt.Fprintf(stderr, "\n=== CLEANUP %s\n", cleanupPkg)
+		if _, err := execOne(cleanupPkg, req.CleanupArgs, true); err != nil {
+			if list := run.AsErrorList(err); list != nil {
+				_ = list.SendToStream(stream)
+			}
+			fmt.Fprintf(stderr, "Cleanup failed: %v\n", err)
+			return false
+		}
+		return true
+	}
+
+	// sendArtifacts sends the artifacts written by the scripts, if collected,
+	// reporting whether they were all sent.
+	sendArtifacts := func() (ok bool) {
+		if artifactDir == "" {
+			return true
+		}
+		n, err := streamArtifacts(stream, artifactDir)
+		if err != nil {
+			fmt.Fprintf(stderr, "Failed to send artifacts: %v\n", err)
+			return false
+		}
+		fmt.Fprintf(stderr, "Collected %d artifacts.\n", n)
+		return true
+	}
+
+	if !req.RunAllCommands {
+		res, err := execOne(commandPkg, req.ScriptArgs, false)
+		cleanupOK := runCleanup()
+		artifactsOK := sendArtifacts()
+		if err != nil {
+			sendErr(err)
+		} else if !cleanupOK || !artifactsOK {
+			streamExit(stream, 1)
+		} else {
+			if req.KeepBinary && res.BinaryPath != "" {
+				fmt.Fprintf(stderr, "\nScript binary kept at: %s\n", res.BinaryPath)
+			}
+			streamExecResult(stream, res)
+		}
+		return nil
+	}
+
+	// Run all the commands sequentially, and report the aggregated results.
+	var failed []paths.Pkg
+	for _, pkg := range commandPkgs {
+		fmt.Fprintf(stderr, "\n=== RUN   %s\n", pkg)
+		if _, err := execOne(pkg, req.ScriptArgs, false); err != nil {
+			if list := run.AsErrorList(err); list != nil {
+				_ = list.SendToStream(stream)
+			} else {
+				fmt.Fprintf(stderr, "%v\n", err)
+			}
+			fmt.Fprintf(stderr, "--- FAIL: %s\n", pkg)
+			failed = append(failed, pkg)
+		} else {
+			fmt.Fprintf(stderr, "--- OK:   %s\n", pkg)
+		}
+	}
+
+	fmt.Fprintf(stderr, "\nRan %d commands, %d failed.\n", len(commandPkgs), len(failed))
+	cleanupOK := runCleanup()
+	artifactsOK := sendArtifacts()
+	if len(failed) > 0 || !cleanupOK || !artifactsOK {
+		streamExit(stream, 1)
 	} else {
 		streamExit(stream, 0)
 	}
 	return nil
 }
+
+// ephemeralNamespaceTimeout is how long deleting an ephemeral namespace may take.
+const ephemeralNamespaceTimeout = time.Minute
+
+// deleteEphemeralNamespace deletes the namespace ns created for a script run,
+// along with its infrastructure, reporting any failure to stderr.
+func (s *Server) deleteEphemeralNamespace(app *apps.Instance, ns *namespace.Namespace, stderr io.Writer) {
+	// The script's context may be canceled, but the namespace must still be deleted.
+	ctx, cancel := context.WithTimeout(context.Background(), ephemeralNamespaceTimeout)
+	defer cancel()
+	if err := s.ns.Delete(ctx, app, ns.Name); err != nil {
+		log.Err(err).Str("namespace", string(ns.Name)).Msg("failed to delete ephemeral namespace")
+		fmt.Fprintf(stderr, "Failed to delete ephemeral namespace %s: %v\n", ns.Name, err)
+	}
+}
+
+// streamExecResult sends a successful exit message to the stream,
+// describing the result of the script execution.
+func streamExecResult(stream commandStream, res *run.ExecScriptResult) {
+	_ = stream.Send(&daemonpb.CommandMessage{Msg: &daemonpb.CommandMessage_Exit{
+		Exit: &daemonpb.CommandExit{
+			Code: 0,
+			ExecResult: &daemonpb.ExecScriptResult{
+				BuildDuration:   durationpb.New(res.BuildDuration),
+				BinaryPath:      res.BinaryPath,
+				GoVersion:       res.GoVersion,
+				CpuTime:         durationpb.New(res.CPUTime),
+				PeakMemoryBytes: res.PeakMemory.PtrOrNil(),
+				Attempts:        uint32(res.Attempts),
+			},
+		},
+	}})
+}
+
+// checkScriptApp checks that root, the app root of a sibling app
+// to run a script from, is the root of an Encore app.
+func checkScriptApp(root string) error {
+	if !filepath.IsAbs(root) {
+		return fmt.Errorf("invalid script app root %q: must be an absolute path", root)
+	}
+	if _, err := os.Stat(filepath.Join(root, appfile.Name)); errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("invalid script app root %s: not an Encore app (no %s file found)", root, appfile.Name)
+	} else if err != nil {
+		return fmt.Errorf("invalid script app root %s: %v", root, err)
+	}
+	if _, err := appfile.ParseFile(filepath.Join(root, appfile.Name)); err != nil {
+		return fmt.Errorf("invalid script app root %s: %v", root, err)
+	}
+	return nil
+}
+
+// namedCommandPath returns the slash-separated path, relative to the app root,
+// to the command declared with the given name in the encore.app file
+// of the app rooted at appRoot. It reports whether there is such a command.
+func namedCommandPath(appRoot, name string) (relPath string, ok bool, err error) {
+	f, err := appfile.ParseFile(filepath.Join(appRoot, appfile.Name))
+	if err != nil {
+		return "", false, err
+	}
+	cmd, ok := f.Command(name)
+	if !ok {
+		return "", false, nil
+	}
+	return filepath.ToSlash(filepath.Clean(filepath.FromSlash(cmd.Path))), true, nil
+}
+
+// resolveCommandPkg resolves the import path of the command package at relPath,
+// the slash-separated path relative to the app root.
+//
+// The package belongs to the module with the closest go.mod file, walking up from
+// the package directory to the app root, whose module has the path rootModPath.
+// If that's a different module, such as in a Go workspace with multiple modules,
+// it must be part of the app's workspace, declared with a "use" directive in the go.work file.
+func resolveCommandPkg(appRoot, rootModPath, relPath string) (paths.Pkg, error) {
+	pkgDir := filepath.Join(appRoot, filepath.FromSlash(relPath))
+	for dir := pkgDir; dir != appRoot; dir = filepath.Dir(dir) {
+		modRel, err := filepath.Rel(appRoot, dir)
+		if err != nil || !filepath.IsLocal(modRel) {
+			break // outside the app; use the app's module
+		}
+		modPath := filepath.Join(dir, "go.mod")
+		modData, err := os.ReadFile(modPath)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		mod, err := modfile.Parse(modPath, modData, nil)
+		if err != nil {
+			return "", err
+		} else if mod.Module == nil {
+			return "", fmt.Errorf("%s: missing module declaration", modPath)
+		}
+		if err := checkWorkspaceModule(appRoot, modRel); err != nil {
+			return "", err
+		}
+
+		pkgRel, err := filepath.Rel(dir, pkgDir)
+		if err != nil {
+			return "", err
+		}
+		return paths.Pkg(mod.Module.Mod.Path).JoinSlash(paths.RelSlash(filepath.ToSlash(pkgRel))), nil
+	}
+	return paths.Pkg(rootModPath).JoinSlash(paths.RelSlash(relPath)), nil
+}
+
+// checkWorkspaceModule reports an error unless the module in the directory modRel,
+// relative to the app root, is part of the Go workspace defined by the app's go.work file.
+func checkWorkspaceModule(appRoot, modRel string) error {
+	workPath := filepath.Join(appRoot, "go.work")
+	workData, err := os.ReadFile(workPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("the script is in the module in %s, which is not the app's module, and the app has no go.work file making it part of a workspace",
+			filepath.ToSlash(modRel))
+	} else if err != nil {
+		return err
+	}
+	work, err := modfile.ParseWork(workPath, workData, nil)
+	if err != nil {
+		return err
+	}
+	for _, use := range work.Use {
+		if filepath.Clean(filepath.FromSlash(use.Path)) == modRel {
+			return nil
+		}
+	}
+	return fmt.Errorf("the script is in the module in %s, which is not part of the app's workspace (add it with \"go work use ./%s\")",
+		filepath.ToSlash(modRel), filepath.ToSlash(modRel))
+}
+
+// findCommandPkgs finds all main packages located within
+// a "cmd" directory in the app rooted at appRoot.
+func findCommandPkgs(appRoot string, modPath string) ([]paths.Pkg, error) {
+	var pkgs []paths.Pkg
+	err := filepath.WalkDir(appRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		} else if !d.IsDir() {
+			return nil
+		}
+
+		name := d.Name()
+		if path != appRoot && (strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") ||
+			name == "node_modules" || name == "vendor" || name == "testdata") {
+			return filepath.SkipDir
+		}
+
+		rel, err := filepath.Rel(appRoot, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if slices.Contains(strings.Split(rel, "/"), "cmd") && isMainPkg(path) {
+			pkgs = append(pkgs, paths.Pkg(modPath).JoinSlash(paths.RelSlash(rel)))
+		}
+		return nil
+	})
+	return pkgs, err
+}
+
+// isMainPkg reports whether the Go package in dir is a main package.
+func isMainPkg(dir string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+
+	fset := token.NewFileSet()
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.PackageClauseOnly)
+		if err == nil {
+			return f.Name.Name == "main"
+		}
+	}
+	return false
+}
+
+// writeExecPlan writes a description of what ExecScript would execute
+// for the given request to w, without building or running anything.
+func writeExecPlan(w io.Writer, req *daemonpb.ExecScriptRequest, commandPkg paths.Pkg, nsName namespace.Name) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Package:     %s\n", commandPkg)
+	command := commandPkg.String()
+	if req.Entrypoint != nil {
+		command += "." + *req.Entrypoint
+	}
+	fmt.Fprintf(&b, "Command:     %s\n", strings.Join(append([]string{command}, req.ScriptArgs...), " "))
+	if req.ScriptAppRoot != nil {
+		fmt.Fprintf(&b, "App:         %s (sibling app)\n", *req.ScriptAppRoot)
+		fmt.Fprintf(&b, "Working dir: %s\n", *req.ScriptAppRoot)
+	} else {
+		fmt.Fprintf(&b, "Working dir: %s\n", filepath.Join(req.AppRoot, req.WorkingDir))
+	}
+	if req.EphemeralNamespace {
+		b.WriteString("Namespace:   ephemeral (created for the run and deleted afterwards)\n")
+	} else {
+		fmt.Fprintf(&b, "Namespace:   %s\n", nsName)
+	}
+	if req.RunAsUser != nil {
+		fmt.Fprintf(&b, "User:        %s\n", *req.RunAsUser)
+	}
+	if req.Standalone {
+		b.WriteString("Build:       standalone (no access to Encore resources)\n")
+	}
+	if req.Race {
+		b.WriteString("Race:        race detector enabled\n")
+	}
+	if req.BuildParallelism > 0 {
+		fmt.Fprintf(&b, "Parallelism: %d\n", req.BuildParallelism)
+	}
+	if req.TempDir != nil {
+		fmt.Fprintf(&b, "Temp dir:    %s\n", *req.TempDir)
+	}
+	if req.Timezone != nil {
+		fmt.Fprintf(&b, "Timezone:    %s\n", *req.Timezone)
+	}
+	if req.Locale != nil {
+		fmt.Fprintf(&b, "Locale:      %s\n", *req.Locale)
+	}
+	if req.MaxRetries > 0 {
+		fmt.Fprintf(&b, "Retries:     up to %d on failure, after %s\n", req.MaxRetries, time.Duration(req.BackoffSeconds)*time.Second)
+	}
+	if req.MinLogLevel != nil {
+		fmt.Fprintf(&b, "Log level:   %s and above\n", *req.MinLogLevel)
+	}
+	if req.LogResourceAccess {
+		b.WriteString("Resources:   logging every database query, Pub/Sub publish and cache operation\n")
+	}
+	if req.ShutdownGraceSeconds > 0 {
+		fmt.Fprintf(&b, "Shutdown:    SIGTERM, killed after %s\n", time.Duration(req.ShutdownGraceSeconds)*time.Second)
+	}
+	if tty := req.GetTty(); tty != nil {
+		fmt.Fprintf(&b, "Terminal:    %dx%d pseudo-terminal\n", tty.Cols, tty.Rows)
+	}
+	if req.SessionId != nil {
+		fmt.Fprintf(&b, "Session:     %s\n", *req.SessionId)
+	} else {
+		b.WriteString("Session:     generated when run\n")
+	}
+	if req.CollectArtifacts {
+		fmt.Fprintf(&b, "Artifacts:   collected from $%s\n", artifactDirEnv)
+	}
+	if req.CleanupCommandRelPath != nil {
+		fmt.Fprintf(&b, "Cleanup:     %s\n", strings.Join(append([]string{*req.CleanupCommandRelPath}, req.CleanupArgs...), " "))
+	}
+
+	// Report the environment variables the request sets for the script, with the
+	// last value of each taking effect. Variables the run sets itself from other
+	// options, like TZ for the timezone, take precedence and are reported above.
+	runSet := make(map[string]bool)
+	if req.Timezone != nil {
+		runSet["TZ"] = true
+	}
+	if req.Locale != nil {
+		runSet["LANG"] = true
+	}
+	if req.CollectArtifacts {
+		runSet[artifactDirEnv] = true
+	}
+	if req.LogResourceAccess {
+		runSet["ENCORE_LOG_RESOURCE_ACCESS"] = true
+	}
+	if req.SessionId != nil {
+		runSet["ENCORE_SCRIPT_SESSION_ID"] = true
+	}
+	if len(req.ContextValues) > 0 {
+		runSet["ENCORE_SCRIPT_CONTEXT"] = true
+	}
+	vars := make(map[string]string)
+	for _, kv := range req.Environ {
+		if key, _, _ := strings.Cut(kv, "="); !runSet[key] {
+			vars[key] = kv
+		}
+	}
+	env := make([]string, 0, len(vars))
+	for _, kv := range vars {
+		env = append(env, kv)
+	}
+	slices.Sort(env)
+	if len(env) == 0 {
+		b.WriteString("Environment: (none)\n")
+	} else {
+		b.WriteString("Environment:\n")
+		for _, kv := range env {
+			fmt.Fprintf(&b, "  %s\n", kv)
+		}
+	}
+	_, _ = w.Write([]byte(b.String()))
+}
+
+// prefixWriter is an io.Writer that prefixes each line written to w with prefix.
+type prefixWriter struct {
+	w       io.Writer
+	prefix  []byte
+	midLine bool // whether the last write ended in the middle of a line
+}
+
+func (pw *prefixWriter) Write(p []byte) (int, error) {
+	var buf []byte
+	for _, line := range bytes.SplitAfter(p, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		if !pw.midLine {
+			buf = append(buf, pw.prefix...)
+		}
+		buf = append(buf, line...)
+		pw.midLine = line[len(line)-1] != '\n'
+	}
+	if _, err := pw.w.Write(buf); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// parseLogLevel parses the minimum level of the structured log records to keep.
+func parseLogLevel(s string) (zerolog.Level, error) {
+	lvl, err := zerolog.ParseLevel(strings.ToLower(s))
+	if err != nil || s == "" || lvl == zerolog.NoLevel || lvl == zerolog.Disabled {
+		return zerolog.NoLevel, fmt.Errorf("invalid log level %q (must be one of trace, debug, info, warn or error)", s)
+	}
+	return lvl, nil
+}
+
+// logLevelFilter drops the structured log records below the level min from the
+// output written to w. Log records are lines with a JSON object with a "level" field
+// (or "severity", as in some clouds), as written by the Encore runtime.
+// Other output is passed through unchanged.
+//
+// Lines that may be log records are buffered until they're complete,
+// so Flush must be called once all output has been written.
+type logLevelFilter struct {
+	w   io.Writer
+	min zerolog.Level
+
+	midLine bool   // whether the last write ended in the middle of a line that's passed through
+	pending []byte // the incomplete line that may be a log record
+}
+
+func (f *logLevelFilter) Write(p []byte) (int, error) {
+	var buf []byte
+	for _, line := range bytes.SplitAfter(p, []byte("\n")) {
+		complete := len(line) > 0 && line[len(line)-1] == '\n'
+		switch {
+		case len(line) == 0:
+			continue
+		case f.pending != nil:
+			f.pending = append(f.pending, line...)
+			if complete {
+				if f.keep(f.pending) {
+					buf = append(buf, f.pending...)
+				}
+				f.pending = nil
+			}
+		case !f.midLine && line[0] == '{':
+			if !complete {
+				f.pending = slices.Clone(line)
+			} else if f.keep(line) {
+				buf = append(buf, line...)
+			}
+		default:
+			buf = append(buf, line...)
+			f.midLine = !complete
+		}
+	}
+	if len(buf) > 0 {
+		if _, err := f.w.Write(buf); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// keep reports whether line should be kept: either it's not
+// a log record, or the record's level is at least f.min.
+func (f *logLevelFilter) keep(line []byte) bool {
+	var record struct {
+		Level    string `json:"level"`
+		Severity string `json:"severity"`
+	}
+	if err := json.Unmarshal(line, &record); err != nil {
+		return true
+	}
+	lvl, err := zerolog.ParseLevel(strings.ToLower(cmp.Or(record.Level, record.Severity)))
+	if err != nil || lvl == zerolog.NoLevel {
+		return true
+	}
+	return lvl >= f.min
+}
+
+// Flush writes the buffered incomplete line, if any.
+func (f *logLevelFilter) Flush() {
+	if f.pending != nil {
+		_, _ = f.w.Write(f.pending)
+		f.pending = nil
+	}
+}
+
+// outputLimiter limits the total size of the output written through the writers
+// it wraps to max bytes. Output beyond that is dropped after writing a marker
+// saying the output was truncated, and onExceed, if set, is called.
+type outputLimiter struct {
+	max      uint64
+	marker   io.Writer // where to write the truncation marker
+	onExceed func()
+
+	mu       sync.Mutex
+	written  uint64
+	exceeded bool
+}
+
+// wrap returns a writer writing to w, subject to the output limit.
+func (l *outputLimiter) wrap(w io.Writer) io.Writer {
+	return &limitedWriter{l: l, w: w}
+}
+
+type limitedWriter struct {
+	l *outputLimiter
+	w io.Writer
+}
+
+func (lw *limitedWriter) Write(p []byte) (int, error) {
+	l := lw.l
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.exceeded {
+		// Pretend the write succeeded, so the script isn't
+		// affected by its output being dropped.
+		return len(p), nil
+	}
+
+	if remaining := l.max - l.written; uint64(len(p)) > remaining {
+		l.exceeded = true
+		l.written = l.max
+		if _, err := lw.w.Write(p[:remaining]); err != nil {
+			return 0, err
+		}
+		action := "the rest of its output is dropped"
+		if l.onExceed != nil {
+			action = "it is being terminated"
+			l.onExceed()
+		}
+		_, _ = fmt.Fprintf(l.marker, "\n[output truncated: the script exceeded the maximum output size of %d bytes, so %s]\n", l.max, action)
+		return len(p), nil
+	}
+
+	l.written += uint64(len(p))
+	return lw.w.Write(p)
+}
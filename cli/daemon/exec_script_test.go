@@ -0,0 +1,329 @@
+package daemon
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/rs/zerolog"
+
+	"encr.dev/pkg/paths"
+)
+
+func TestParseLogLevel(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    zerolog.Level
+		wantErr bool
+	}{
+		{in: "trace", want: zerolog.TraceLevel},
+		{in: "debug", want: zerolog.DebugLevel},
+		{in: "info", want: zerolog.InfoLevel},
+		{in: "WARN", want: zerolog.WarnLevel},
+		{in: "error", want: zerolog.ErrorLevel},
+		{in: "", wantErr: true},
+		{in: "verbose", wantErr: true},
+		{in: "disabled", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			c := qt.New(t)
+			got, err := parseLogLevel(test.in)
+			if test.wantErr {
+				c.Assert(err, qt.ErrorMatches, `invalid log level ".*" \(must be one of trace, debug, info, warn or error\)`)
+				return
+			}
+			c.Assert(err, qt.IsNil)
+			c.Assert(got, qt.Equals, test.want)
+		})
+	}
+}
+
+func TestLogLevelFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		writes []string
+		want   string
+	}{
+		{
+			name:   "drops_below_min",
+			writes: []string{`{"level":"debug","message":"a"}` + "\n" + `{"level":"info","message":"b"}` + "\n"},
+			want:   `{"level":"info","message":"b"}` + "\n",
+		},
+		{
+			name:   "severity",
+			writes: []string{`{"severity":"DEBUG"}` + "\n" + `{"severity":"ERROR"}` + "\n"},
+			want:   `{"severity":"ERROR"}` + "\n",
+		},
+		{
+			name:   "unknown_level",
+			writes: []string{`{"level":"verbose"}` + "\n"},
+			want:   `{"level":"verbose"}` + "\n",
+		},
+		{
+			name:   "not_log_records",
+			writes: []string{"plain output\n", "{not json}\n", `{"message":"no level"}` + "\n"},
+			want:   "plain output\n{not json}\n" + `{"message":"no level"}` + "\n",
+		},
+		{
+			name:   "split_record",
+			writes: []string{`{"level":"de`, `bug"}` + "\n" + `{"level":"in`, `fo"}` + "\n"},
+			want:   `{"level":"info"}` + "\n",
+		},
+		{
+			name:   "brace_mid_line",
+			writes: []string{"partial ", `{"level":"debug"}` + "\n"},
+			want:   "partial " + `{"level":"debug"}` + "\n",
+		},
+		{
+			name:   "incomplete_at_end",
+			writes: []string{`{"level":"debug"}`},
+			want:   `{"level":"debug"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := qt.New(t)
+			var out bytes.Buffer
+			f := &logLevelFilter{w: &out, min: zerolog.InfoLevel}
+			for _, w := range test.writes {
+				n, err := f.Write([]byte(w))
+				c.Assert(err, qt.IsNil)
+				c.Assert(n, qt.Equals, len(w))
+			}
+			f.Flush()
+			c.Assert(out.String(), qt.Equals, test.want)
+		})
+	}
+}
+
+func TestOutputLimiter(t *testing.T) {
+	const marker = "\n[output truncated: the script exceeded the maximum output size of 10 bytes, so the rest of its output is dropped]\n"
+	tests := []struct {
+		name       string
+		writes     []string
+		want       string
+		wantMarker bool
+	}{
+		{
+			name:   "below_limit",
+			writes: []string{"hello"},
+			want:   "hello",
+		},
+		{
+			name:   "exact_limit",
+			writes: []string{"hello", "world"},
+			want:   "helloworld",
+		},
+		{
+			name:       "one_over_limit",
+			writes:     []string{"helloworld", "!"},
+			want:       "helloworld",
+			wantMarker: true,
+		},
+		{
+			name:       "single_write_over_limit",
+			writes:     []string{"hello world"},
+			want:       "hello worl",
+			wantMarker: true,
+		},
+		{
+			name:       "drops_after_exceeded",
+			writes:     []string{"hello world", "more"},
+			want:       "hello worl",
+			wantMarker: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := qt.New(t)
+			var out, markers bytes.Buffer
+			l := &outputLimiter{max: 10, marker: &markers}
+			w := l.wrap(&out)
+			for _, s := range test.writes {
+				n, err := w.Write([]byte(s))
+				c.Assert(err, qt.IsNil)
+				c.Assert(n, qt.Equals, len(s))
+			}
+			c.Assert(out.String(), qt.Equals, test.want)
+			if test.wantMarker {
+				c.Assert(markers.String(), qt.Equals, marker)
+			} else {
+				c.Assert(markers.String(), qt.Equals, "")
+			}
+		})
+	}
+}
+
+func TestOutputLimiterShared(t *testing.T) {
+	c := qt.New(t)
+	var stdout, stderr, markers bytes.Buffer
+	exceeded := 0
+	l := &outputLimiter{max: 8, marker: &markers, onExceed: func() { exceeded++ }}
+	outW, errW := l.wrap(&stdout), l.wrap(&stderr)
+
+	_, _ = outW.Write([]byte("12345"))
+	_, _ = errW.Write([]byte("6789"))
+	_, _ = outW.Write([]byte("more"))
+	c.Assert(stdout.String(), qt.Equals, "12345")
+	c.Assert(stderr.String(), qt.Equals, "678")
+	c.Assert(exceeded, qt.Equals, 1)
+	c.Assert(strings.Count(markers.String(), "output truncated"), qt.Equals, 1)
+	c.Assert(markers.String(), qt.Contains, "it is being terminated")
+}
+
+func TestNamedCommandPath(t *testing.T) {
+	c := qt.New(t)
+	appRoot := t.TempDir()
+	writeFile(c, filepath.Join(appRoot, "encore.app"), `{
+		// Named commands.
+		"id": "",
+		"commands": [
+			{"name": "seed", "path": "tools/seeder"},
+			{"name": "migrate", "path": "./cmd/migrate/"},
+		],
+	}`)
+
+	tests := []struct {
+		name    string
+		arg     string
+		wantPkg paths.Pkg
+	}{
+		{name: "named", arg: "seed", wantPkg: "example.com/app/tools/seeder"},
+		{name: "named_cleaned", arg: "migrate", wantPkg: "example.com/app/cmd/migrate"},
+		{name: "path", arg: "cmd/seed", wantPkg: "example.com/app/cmd/seed"},
+		{name: "undeclared", arg: "tools", wantPkg: "example.com/app/tools"},
+	}
+	for _, test := range tests {
+		c.Run(test.name, func(c *qt.C) {
+			// Resolve the argument the way ExecScript does: by name first,
+			// falling back to treating it as a path.
+			relPath, ok, err := namedCommandPath(appRoot, test.arg)
+			c.Assert(err, qt.IsNil)
+			if !ok {
+				relPath = test.arg
+			}
+			pkg, err := resolveCommandPkg(appRoot, "example.com/app", relPath)
+			c.Assert(err, qt.IsNil)
+			c.Assert(pkg, qt.Equals, test.wantPkg)
+		})
+	}
+
+	c.Run("no_app_file", func(c *qt.C) {
+		_, ok, err := namedCommandPath(t.TempDir(), "seed")
+		c.Assert(err, qt.IsNil)
+		c.Assert(ok, qt.IsFalse)
+	})
+
+	c.Run("invalid_app_file", func(c *qt.C) {
+		root := t.TempDir()
+		writeFile(c, filepath.Join(root, "encore.app"), `{"commands": [{"name": "seed", "path": "../seed"}]}`)
+		_, _, err := namedCommandPath(root, "seed")
+		c.Assert(err, qt.ErrorMatches, `appfile.Parse: command "seed": invalid path .*`)
+	})
+}
+
+func writeFile(c *qt.C, path, data string) {
+	c.Assert(os.MkdirAll(filepath.Dir(path), 0755), qt.IsNil)
+	c.Assert(os.WriteFile(path, []byte(data), 0644), qt.IsNil)
+}
+
+func TestResolveCommandPkg(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		relPath string
+		want    paths.Pkg
+		wantErr string
+	}{
+		{
+			name:    "app_module",
+			relPath: "cmd/seed",
+			want:    "example.com/app/cmd/seed",
+		},
+		{
+			name:    "missing_pkg",
+			relPath: "cmd/missing",
+			want:    "example.com/app/cmd/missing",
+		},
+		{
+			name: "workspace_module",
+			files: map[string]string{
+				"go.work":             "go 1.22\n\nuse (\n\t.\n\t./tools\n)\n",
+				"tools/go.mod":        "module example.com/tools\n",
+				"tools/cmd/seed/x.go": "package main\n",
+			},
+			relPath: "tools/cmd/seed",
+			want:    "example.com/tools/cmd/seed",
+		},
+		{
+			name: "workspace_module_root",
+			files: map[string]string{
+				"go.work":       "go 1.22\n\nuse ./tools/\n",
+				"tools/go.mod":  "module example.com/tools\n",
+				"tools/main.go": "package main\n",
+			},
+			relPath: "tools",
+			want:    "example.com/tools",
+		},
+		{
+			name: "missing_pkg_in_workspace_module",
+			files: map[string]string{
+				"go.work":      "go 1.22\n\nuse ./tools\n",
+				"tools/go.mod": "module example.com/tools\n",
+			},
+			relPath: "tools/cmd/missing",
+			want:    "example.com/tools/cmd/missing",
+		},
+		{
+			name: "module_outside_workspace",
+			files: map[string]string{
+				"go.work":             "go 1.22\n\nuse .\n",
+				"tools/go.mod":        "module example.com/tools\n",
+				"tools/cmd/seed/x.go": "package main\n",
+			},
+			relPath: "tools/cmd/seed",
+			wantErr: `the script is in the module in tools, which is not part of the app's workspace \(add it with "go work use ./tools"\)`,
+		},
+		{
+			name: "module_without_workspace",
+			files: map[string]string{
+				"tools/go.mod":        "module example.com/tools\n",
+				"tools/cmd/seed/x.go": "package main\n",
+			},
+			relPath: "tools/cmd/seed",
+			wantErr: `the script is in the module in tools, which is not the app's module, and the app has no go.work file making it part of a workspace`,
+		},
+		{
+			name: "module_without_declaration",
+			files: map[string]string{
+				"go.work":      "go 1.22\n\nuse ./tools\n",
+				"tools/go.mod": "go 1.22\n",
+			},
+			relPath: "tools/cmd/seed",
+			wantErr: `.*tools/go.mod: missing module declaration`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := qt.New(t)
+			appRoot := t.TempDir()
+			writeFile(c, filepath.Join(appRoot, "go.mod"), "module example.com/app\n")
+			writeFile(c, filepath.Join(appRoot, "cmd/seed/main.go"), "package main\n")
+			for name, data := range test.files {
+				writeFile(c, filepath.Join(appRoot, filepath.FromSlash(name)), data)
+			}
+
+			got, err := resolveCommandPkg(appRoot, "example.com/app", test.relPath)
+			if test.wantErr != "" {
+				c.Assert(err, qt.ErrorMatches, test.wantErr)
+				return
+			}
+			c.Assert(err, qt.IsNil)
+			c.Assert(got, qt.Equals, test.want)
+		})
+	}
+}
@@ -2,14 +2,20 @@ package run
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"runtime"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/cockroachdb/errors"
 
@@ -18,6 +24,7 @@ import (
 	"encr.dev/cli/daemon/run/infra"
 	encoreEnv "encr.dev/internal/env"
 	"encr.dev/internal/optracker"
+	"encr.dev/pkg/appfile"
 	"encr.dev/pkg/builder"
 	"encr.dev/pkg/builder/builderimpl"
 	"encr.dev/pkg/cueutil"
@@ -26,6 +33,8 @@ import (
 	"encr.dev/pkg/paths"
 	"encr.dev/pkg/promise"
 	"encr.dev/pkg/vcs"
+	"encr.dev/pkg/xos"
+	meta "encr.dev/proto/encore/parser/meta/v1"
 )
 
 // ExecScriptParams groups the parameters for the ExecScript method.
@@ -53,17 +62,182 @@ type ExecScriptParams struct {
 	// Stdout and Stderr are where "go test" output should be written.
 	Stdout, Stderr io.Writer
 
+	// Stdin, if non-nil, is forwarded to the script's standard input.
+	// The script doesn't wait for Stdin to be exhausted before exiting.
+	Stdin io.Reader
+
 	OpTracker *optracker.OpTracker
+
+	// BuildVerbosity controls the amount of build output written to Stderr.
+	BuildVerbosity BuildVerbosity
+
+	// RunAsUser, if set, is the OS user to run the script as.
+	RunAsUser option.Option[string]
+
+	// KeepBinary, if true, keeps the compiled script binary after the script exits
+	// when it's built in a temporary directory, as for TempDir and standalone scripts,
+	// which is otherwise deleted. Binaries in the app's build cache are always kept.
+	KeepBinary bool
+
+	// ContextValues are key-value pairs made available to the script
+	// through encore.ScriptContextValues().
+	ContextValues map[string]string
+
+	// SecretOverrides are secret values to use for this execution only,
+	// keyed by secret name. They take precedence over the app's local secrets.
+	SecretOverrides map[string]string
+
+	// SessionID groups related script executions in logs and traces.
+	// It's made available to the script's runtime, which includes it in its log records.
+	SessionID string
+
+	// Standalone, if true, builds only the script's main package as a plain
+	// Go program, skipping parsing the app and generating Encore code.
+	// The Encore runtime is not configured in this mode, so the script
+	// cannot access any Encore resources.
+	Standalone bool
+
+	// Race, if true, builds the script with the Go race detector enabled.
+	Race bool
+
+	// BuildParallelism, if non-zero, is the number of programs the Go toolchain
+	// may run in parallel when building the script (its -p flag).
+	// Zero means the Go default, the number of CPUs available.
+	BuildParallelism int
+
+	// Entrypoint, if set, is the name of an exported function in MainPkg to run
+	// as the script, in which case MainPkg needn't be a main package.
+	Entrypoint option.Option[string]
+
+	// TempDir, if set, is the directory to stage the script's build in,
+	// instead of the system's temporary directory. It must be writable
+	// and allow executing files, since the script binary is run from it.
+	// The build's work dir within it is deleted after the script exits,
+	// unless KeepBinary is set.
+	TempDir option.Option[string]
+
+	// Timezone, if set, is the IANA time zone to run the script in,
+	// set as its TZ environment variable. It must be in the system's time zone database.
+	Timezone option.Option[string]
+
+	// Locale, if set, is the locale to run the script in, like "sv_SE.UTF-8",
+	// set as its LANG environment variable.
+	Locale option.Option[string]
+
+	// TTY, if set, runs the script in a pseudo-terminal of the given size,
+	// for scripts that behave differently when attached to a terminal.
+	// The script's standard output and error are then both written to Stdout.
+	// If pseudo-terminals aren't supported on the platform, the script runs
+	// without one, after a note is written to Stderr.
+	TTY option.Option[TerminalSize]
+
+	// MaxRetries is the number of times to re-run the script if it exits
+	// with a non-zero status. Build failures aren't retried.
+	// Standard input is only forwarded to the first attempt.
+	MaxRetries int
+
+	// RetryBackoff is how long to wait before the first retry.
+	// It's doubled for each subsequent retry.
+	RetryBackoff time.Duration
+
+	// ShutdownGrace, if non-zero, is how long the script has to exit after being
+	// sent SIGTERM when the context is canceled, before it's killed.
+	// If zero, it's killed right away.
+	ShutdownGrace time.Duration
+
+	// LogResourceAccess, if true, makes the runtime log every database query,
+	// Pub/Sub publish and cache operation the script performs.
+	LogResourceAccess bool
+}
+
+// TerminalSize is the size of a terminal, in characters.
+type TerminalSize struct {
+	Rows, Cols uint16
+}
+
+// BuildVerbosity controls the amount of build output to report.
+type BuildVerbosity int
+
+const (
+	// BuildNormal reports build errors only, in addition to
+	// the progress reported through the OpTracker.
+	BuildNormal BuildVerbosity = iota
+
+	// BuildQuiet reports build errors only.
+	BuildQuiet
+
+	// BuildVerbose additionally streams the output of the Go build,
+	// running it with the -v and -x flags.
+	BuildVerbose
+)
+
+// ExecScriptResult describes the result of a successful script execution.
+type ExecScriptResult struct {
+	// BuildDuration is how long it took to compile the script.
+	BuildDuration time.Duration
+
+	// BinaryPath is the path to the compiled script binary. It no longer exists
+	// if it was built in a temporary directory, unless ExecScriptParams.KeepBinary is set.
+	BinaryPath string
+
+	// GoVersion is the version of the Go toolchain that compiled the script,
+	// like "go1.22.2". It's empty if the script wasn't compiled with Go.
+	GoVersion string
+
+	// CPUTime is the total user and system CPU time used by the script process.
+	CPUTime time.Duration
+
+	// PeakMemory is the peak resident set size of the script process, in bytes.
+	// It's not set if the platform doesn't report it.
+	PeakMemory option.Option[uint64]
+
+	// Attempts is the number of times the script was run,
+	// including retries (see ExecScriptParams.MaxRetries).
+	Attempts int
+}
+
+// recordUsage records the resource usage of the exited script process in res.
+func (res *ExecScriptResult) recordUsage(state *os.ProcessState) {
+	if state == nil {
+		return
+	}
+	res.CPUTime = state.UserTime() + state.SystemTime()
+	if peak, ok := xos.PeakMemory(state); ok {
+		res.PeakMemory = option.Some(peak)
+	}
 }
 
 // ExecScript executes the script.
-func (mgr *Manager) ExecScript(ctx context.Context, p ExecScriptParams) (err error) {
+func (mgr *Manager) ExecScript(ctx context.Context, p ExecScriptParams) (res *ExecScriptResult, err error) {
+	if p.Race && p.App.Lang() != appfile.LangGo {
+		return nil, errors.New("the race detector is only supported for Go apps")
+	} else if p.Entrypoint.Present() && p.App.Lang() != appfile.LangGo {
+		return nil, errors.New("script entrypoints are only supported for Go apps")
+	}
+	if dir, ok := p.TempDir.Get(); ok {
+		if err := checkTempDir(dir); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkLocale(p); err != nil {
+		return nil, err
+	}
+	if p.Standalone {
+		return mgr.execStandaloneScript(ctx, p)
+	}
+
 	expSet, err := p.App.Experiments(p.Environ)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	scriptContextEnv, err := encodeScriptContext(p.ContextValues)
+	if err != nil {
+		return nil, err
 	}
 
 	rm := infra.NewResourceManager(p.App, mgr.ClusterMgr, p.NS, p.Environ, mgr.DBProxyPort, false)
+	rm.ReportDatabaseSetup()
 	defer rm.StopAll()
 
 	tracker := p.OpTracker
@@ -89,6 +263,19 @@ func (mgr *Manager) ExecScript(ctx context.Context, p ExecScriptParams) (err err
 		Revision:           vcsRevision.Revision,
 		UncommittedChanges: vcsRevision.Uncommitted,
 		MainPkg:            option.Some(p.MainPkg),
+
+		ExecScriptEntrypoint: p.Entrypoint,
+		TempDir:              p.TempDir,
+	}
+	if p.BuildVerbosity == BuildVerbose {
+		buildInfo.GoBuildFlags = []string{"-v", "-x"}
+		buildInfo.GoBuildLog = option.Some(p.Stderr)
+	}
+	if p.Race {
+		buildInfo.GoBuildFlags = append(buildInfo.GoBuildFlags, "-race")
+	}
+	if p.BuildParallelism > 0 {
+		buildInfo.GoBuildFlags = append(buildInfo.GoBuildFlags, "-p", strconv.Itoa(p.BuildParallelism))
 	}
 
 	parse, err := bld.Parse(ctx, builder.ParseParams{
@@ -100,10 +287,10 @@ func (mgr *Manager) ExecScript(ctx context.Context, p ExecScriptParams) (err err
 	})
 	if err != nil {
 		tracker.Fail(parseOp, err)
-		return err
+		return nil, err
 	}
 	if err := p.App.CacheMetadata(parse.Meta); err != nil {
-		return errors.Wrap(err, "cache metadata")
+		return nil, errors.Wrap(err, "cache metadata")
 	}
 	tracker.Done(parseOp, 500*time.Millisecond)
 	tracker.Done(topoOp, 300*time.Millisecond)
@@ -136,8 +323,15 @@ func (mgr *Manager) ExecScript(ctx context.Context, p ExecScriptParams) (err err
 		})
 	})
 
-	var build *builder.CompileResult
+	var (
+		build         *builder.CompileResult
+		buildDuration time.Duration
+	)
 	jobs.Go("Compiling application source code", false, 0, func(ctx context.Context) (err error) {
+		buildStart := time.Now()
+		defer func() { buildDuration = time.Since(buildStart) }()
+		stopHeartbeat := startBuildHeartbeat(p)
+		defer stopHeartbeat()
 		build, err = bld.Compile(ctx, builder.CompileParams{
 			Build:       buildInfo,
 			App:         p.App,
@@ -153,7 +347,21 @@ func (mgr *Manager) ExecScript(ctx context.Context, p ExecScriptParams) (err err
 	})
 
 	if err := jobs.Wait(); err != nil {
-		return err
+		return nil, err
+	}
+	// Builds staged in a temp dir get a fresh work dir holding the binary,
+	// rather than reusing the persistent one, so it's ours to delete.
+	if dir, ok := p.TempDir.Get(); ok && !p.KeepBinary {
+		for _, out := range build.Outputs {
+			workdir := out.GetArtifactDir().ToIO()
+			if rel, err := filepath.Rel(dir, workdir); err == nil && filepath.IsLocal(rel) {
+				defer func() { _ = os.RemoveAll(workdir) }()
+			}
+		}
+	}
+	secrets, err = applySecretOverrides(parse.Meta, secrets, p.SecretOverrides)
+	if err != nil {
+		return nil, err
 	}
 
 	gateways := make(map[string]GatewayConfig)
@@ -166,17 +374,19 @@ func (mgr *Manager) ExecScript(ctx context.Context, p ExecScriptParams) (err err
 
 	outputs := build.Outputs
 	if len(outputs) != 1 {
-		return errors.New("ExecScript currently only supports a single build output")
+		return nil, errors.New("ExecScript currently only supports a single build output")
 	}
 	entrypoints := outputs[0].GetEntrypoints()
 	if len(entrypoints) != 1 {
-		return errors.New("ExecScript currently only supports a single entrypoint")
+		return nil, errors.New("ExecScript currently only supports a single entrypoint")
 	}
 	proc := entrypoints[0].Cmd.Expand(outputs[0].GetArtifactDir())
 
+	configOp := tracker.Add("Generating runtime configuration", time.Now())
 	cfg, err := configProm.Get(ctx)
 	if err != nil {
-		return err
+		tracker.Fail(configOp, err)
+		return nil, err
 	}
 
 	authKey := genAuthKey()
@@ -195,12 +405,15 @@ func (mgr *Manager) ExecScript(ctx context.Context, p ExecScriptParams) (err err
 	}
 	procConf, err := configGen.AllInOneProc()
 	if err != nil {
-		return err
+		tracker.Fail(configOp, err)
+		return nil, err
 	}
 	procEnv, err := configGen.ProcEnvs(procConf, bld.UseNewRuntimeConfig())
 	if err != nil {
-		return errors.Wrap(err, "compute proc envs")
+		tracker.Fail(configOp, err)
+		return nil, errors.Wrap(err, "compute proc envs")
 	}
+	tracker.Done(configOp, 100*time.Millisecond)
 
 	env := append(os.Environ(), proc.Env...)
 	env = append(env, p.Environ...)
@@ -211,15 +424,261 @@ func (mgr *Manager) ExecScript(ctx context.Context, p ExecScriptParams) (err err
 	if runtimeLibPath := encoreEnv.EncoreRuntimeLib(); runtimeLibPath != "" {
 		env = append(env, "ENCORE_RUNTIME_LIB="+runtimeLibPath)
 	}
-
-	tracker.AllDone()
+	if scriptContextEnv != "" {
+		env = append(env, scriptContextEnv)
+	}
+	if p.LogResourceAccess {
+		env = append(env, "ENCORE_LOG_RESOURCE_ACCESS=1")
+	}
+	if p.SessionID != "" {
+		env = append(env, "ENCORE_SCRIPT_SESSION_ID="+p.SessionID)
+	}
 
 	args := append(slices.Clone(proc.Command[1:]), p.ScriptArgs...)
+	state, attempts, err := runScript(ctx, p, proc.Command[0], args, env)
+	if err != nil {
+		return nil, err
+	}
+	res = &ExecScriptResult{BuildDuration: buildDuration, BinaryPath: proc.Command[0], Attempts: attempts}
+	res.recordUsage(state)
+	if p.App.Lang() == appfile.LangGo {
+		res.GoVersion = goToolchainVersion(ctx, encoreEnv.EncoreGoRoot())
+	}
+	return res, nil
+}
+
+// goToolchainVersion reports the version of the Go toolchain at goroot,
+// like "go1.22.2". It returns "" if the version cannot be determined.
+func goToolchainVersion(ctx context.Context, goroot string) string {
+	// Release toolchains record their version on the first line of $GOROOT/VERSION.
+	if data, err := os.ReadFile(filepath.Join(goroot, "VERSION")); err == nil {
+		version, _, _ := strings.Cut(string(data), "\n")
+		if version = strings.TrimSpace(version); strings.HasPrefix(version, "go") {
+			return version
+		}
+	}
+
+	// Otherwise ask the toolchain itself.
+	goBin := filepath.Join(goroot, "bin", "go")
+	if runtime.GOOS == "windows" {
+		goBin += ".exe"
+	}
 	// nosemgrep: go.lang.security.audit.dangerous-exec-command.dangerous-exec-command
-	cmd := exec.CommandContext(ctx, proc.Command[0], args...)
+	cmd := exec.CommandContext(ctx, goBin, "env", "GOVERSION")
+	cmd.Env = append(os.Environ(), "GOROOT="+goroot)
+	out, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// runScript runs the script with startScript, re-running it up to p.MaxRetries times
+// if it exits with a non-zero status, with a marker written to Stderr between attempts.
+// It returns the state of the last exited script process and the number of attempts.
+func runScript(ctx context.Context, p ExecScriptParams, binary string, args, env []string) (*os.ProcessState, int, error) {
+	backoff := p.RetryBackoff
+	for attempt := 1; ; attempt++ {
+		state, err := startScript(ctx, p, binary, args, env)
+		var exitErr *exec.ExitError
+		if err == nil || attempt > p.MaxRetries || !errors.As(err, &exitErr) || ctx.Err() != nil {
+			return state, attempt, err
+		}
+
+		fmt.Fprintf(p.Stderr, "--- attempt %d of %d failed: %v; retrying in %s ---\n", attempt, p.MaxRetries+1, err, backoff)
+		select {
+		case <-ctx.Done():
+			return state, attempt, err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+
+		// The progress display is stopped once the first attempt has started,
+		// and its standard input has been consumed.
+		p.OpTracker = nil
+		p.Stdin = nil
+	}
+}
+
+// startScript runs the script binary with the given arguments and environment,
+// and waits for it to exit. It stops the progress display once the script has started.
+// It returns the state of the exited script process.
+func startScript(ctx context.Context, p ExecScriptParams, binary string, args, env []string) (*os.ProcessState, error) {
+	tracker := p.OpTracker
+	runOp := tracker.Add("Starting script", time.Now())
+	// nosemgrep: go.lang.security.audit.dangerous-exec-command.dangerous-exec-command
+	cmd := exec.CommandContext(ctx, binary, args...)
 	cmd.Dir = filepath.Join(p.App.Root(), p.WorkingDir)
 	cmd.Stdout = p.Stdout
 	cmd.Stderr = p.Stderr
 	cmd.Env = env
-	return cmd.Run()
+	defer handleShutdown(cmd, p)()
+	if tz, ok := p.Timezone.Get(); ok {
+		cmd.Env = append(cmd.Env, "TZ="+tz)
+	}
+	if locale, ok := p.Locale.Get(); ok {
+		cmd.Env = append(cmd.Env, "LANG="+locale)
+	}
+	if usr, ok := p.RunAsUser.Get(); ok {
+		if err := xos.RunAsUser(cmd, usr); err != nil {
+			tracker.Fail(runOp, err)
+			return nil, errors.Wrap(err, "run as user")
+		}
+	}
+	if size, ok := p.TTY.Get(); ok {
+		ptmx, err := xos.StartPTY(cmd, size.Rows, size.Cols)
+		if err == nil {
+			tracker.Done(runOp, 0)
+			tracker.AllDone()
+			err := waitPTY(cmd, ptmx, p)
+			return cmd.ProcessState, err
+		} else if !errors.Is(err, xos.ErrPTYUnsupported) {
+			tracker.Fail(runOp, err)
+			return nil, errors.Wrap(err, "start script in a pseudo-terminal")
+		}
+		fmt.Fprintf(p.Stderr, "note: %v; running the script without a terminal\n", err)
+	}
+
+	var stdin io.WriteCloser
+	if p.Stdin != nil {
+		// Use a pipe rather than setting cmd.Stdin, so that waiting for the
+		// script to exit doesn't also wait for p.Stdin to be exhausted.
+		var err error
+		if stdin, err = cmd.StdinPipe(); err != nil {
+			tracker.Fail(runOp, err)
+			return nil, err
+		}
+	}
+	if err := cmd.Start(); err != nil {
+		tracker.Fail(runOp, err)
+		return nil, err
+	}
+	tracker.Done(runOp, 0)
+	if stdin != nil {
+		go func() {
+			_, _ = io.Copy(stdin, p.Stdin)
+			_ = stdin.Close()
+		}()
+	}
+
+	// Stop the progress display before the script starts writing output.
+	tracker.AllDone()
+	err := cmd.Wait()
+	return cmd.ProcessState, err
+}
+
+// waitPTY forwards the script's input and output through the controlling end ptmx
+// of its pseudo-terminal, and waits for it to exit.
+func waitPTY(cmd *exec.Cmd, ptmx *os.File, p ExecScriptParams) error {
+	defer func() { _ = ptmx.Close() }()
+	if p.Stdin != nil {
+		go func() { _, _ = io.Copy(ptmx, p.Stdin) }()
+	}
+	copied := make(chan struct{})
+	go func() {
+		// Reading fails once the script has exited and the terminal is closed.
+		_, _ = io.Copy(p.Stdout, ptmx)
+		close(copied)
+	}()
+
+	err := cmd.Wait()
+	select {
+	case <-copied:
+	case <-time.After(time.Second):
+		// The terminal is kept open by a process the script started.
+	}
+	return err
+}
+
+// localeRe matches POSIX locale names, like "C", "en_US" or "sv_SE.UTF-8@euro".
+var localeRe = regexp.MustCompile(`^[A-Za-z]+(_[A-Za-z0-9]+)?(\.[A-Za-z0-9_-]+)?(@[A-Za-z0-9_-]+)?$`)
+
+// checkLocale checks the time zone and locale to run the script in, if set.
+func checkLocale(p ExecScriptParams) error {
+	if tz, ok := p.Timezone.Get(); ok {
+		// The empty string and "Local" are accepted by time.LoadLocation, but aren't time zones.
+		if tz == "" || tz == "Local" {
+			return errors.Newf("invalid timezone %q", tz)
+		} else if _, err := time.LoadLocation(tz); err != nil {
+			return errors.Newf("invalid timezone %q: not found in the system's time zone database", tz)
+		}
+	}
+	if locale, ok := p.Locale.Get(); ok && !localeRe.MatchString(locale) {
+		return errors.Newf("invalid locale %q (must be a locale name like \"en_US.UTF-8\")", locale)
+	}
+	return nil
+}
+
+// checkTempDir checks that dir can be used to stage a script's build:
+// that it's a writable directory, and that files in it can be executed.
+func checkTempDir(dir string) error {
+	if info, err := os.Stat(dir); err != nil {
+		return errors.Wrap(err, "invalid temp dir")
+	} else if !info.IsDir() {
+		return errors.Newf("invalid temp dir %s: not a directory", dir)
+	}
+
+	f, err := os.CreateTemp(dir, "encore-exec-check")
+	if err != nil {
+		return errors.Newf("invalid temp dir %s: not writable: %v", dir, err)
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	_ = f.Close()
+	if err := os.Chmod(f.Name(), 0o755); err != nil {
+		return errors.Wrapf(err, "invalid temp dir %s", dir)
+	}
+	if !xos.CanExecute(f.Name()) {
+		return errors.Newf("invalid temp dir %s: it doesn't allow executing files, "+
+			"which is needed to run the script (is it mounted with noexec?)", dir)
+	}
+	return nil
+}
+
+// encodeScriptContext validates the script context values and encodes them
+// as an environment variable for the runtime to read.
+// It returns "" if there are no values.
+func encodeScriptContext(values map[string]string) (string, error) {
+	if len(values) == 0 {
+		return "", nil
+	}
+	for k, v := range values {
+		if k == "" {
+			return "", errors.New("invalid script context: keys must not be empty")
+		} else if !utf8.ValidString(k) || !utf8.ValidString(v) {
+			return "", errors.Newf("invalid script context: key %q: keys and values must be valid UTF-8", k)
+		}
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", errors.Wrap(err, "encode script context")
+	}
+	return "ENCORE_SCRIPT_CONTEXT=" + string(data), nil
+}
+
+// applySecretOverrides returns the secrets with the given overrides applied.
+// It reports an error if an override refers to a secret the app doesn't define.
+// The secrets map is not modified.
+func applySecretOverrides(md *meta.Data, secrets, overrides map[string]string) (map[string]string, error) {
+	if len(overrides) == 0 {
+		return secrets, nil
+	}
+
+	defined := make(map[string]bool)
+	for _, pkg := range md.Pkgs {
+		for _, name := range pkg.Secrets {
+			defined[name] = true
+		}
+	}
+
+	result := maps.Clone(secrets)
+	if result == nil {
+		result = make(map[string]string, len(overrides))
+	}
+	for name, value := range overrides {
+		if !defined[name] {
+			return nil, errors.Newf("invalid secret override: the app does not define a secret named %q", name)
+		}
+		result[name] = value
+	}
+	return result, nil
 }
@@ -0,0 +1,134 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	encoreEnv "encr.dev/internal/env"
+	"encr.dev/pkg/appfile"
+)
+
+// execStandaloneScript builds and runs the script as a plain Go program,
+// without parsing the app or generating any Encore code.
+//
+// It's much faster than a full build, but the Encore runtime is not configured:
+// the script cannot access any Encore resources, such as databases, Pub/Sub topics,
+// caches, secrets or calls to the app's APIs. It's meant for trivial scripts
+// that don't need them.
+func (mgr *Manager) execStandaloneScript(ctx context.Context, p ExecScriptParams) (res *ExecScriptResult, err error) {
+	if p.App.Lang() != appfile.LangGo {
+		return nil, errors.New("standalone scripts are only supported for Go apps")
+	} else if p.Entrypoint.Present() {
+		return nil, errors.New("script entrypoints cannot be used with standalone scripts")
+	} else if len(p.SecretOverrides) > 0 {
+		return nil, errors.New("secret overrides cannot be used with standalone scripts, which have no access to secrets")
+	} else if p.LogResourceAccess {
+		return nil, errors.New("resource access logging cannot be used with standalone scripts, which have no access to resources")
+	}
+
+	scriptContextEnv, err := encodeScriptContext(p.ContextValues)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpDir, err := os.MkdirTemp(p.TempDir.GetOrElse(""), "encore-exec-standalone")
+	if err != nil {
+		return nil, errors.Wrap(err, "create build dir")
+	}
+	binary := filepath.Join(tmpDir, "script")
+	if runtime.GOOS == "windows" {
+		binary += ".exe"
+	}
+	if !p.KeepBinary {
+		defer func() { _ = os.RemoveAll(tmpDir) }()
+	}
+
+	tracker := p.OpTracker
+	buildStart := time.Now()
+	buildOp := tracker.Add("Compiling script", buildStart)
+	stopHeartbeat := startBuildHeartbeat(p)
+	err = buildStandaloneScript(ctx, p, binary)
+	stopHeartbeat()
+	if err != nil {
+		tracker.Fail(buildOp, err)
+		return nil, err
+	}
+	tracker.Done(buildOp, 0)
+	buildDuration := time.Since(buildStart)
+
+	env := append(os.Environ(), p.Environ...)
+	if scriptContextEnv != "" {
+		env = append(env, scriptContextEnv)
+	}
+	if p.SessionID != "" {
+		env = append(env, "ENCORE_SCRIPT_SESSION_ID="+p.SessionID)
+	}
+	state, attempts, err := runScript(ctx, p, binary, p.ScriptArgs, env)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &ExecScriptResult{
+		BuildDuration: buildDuration,
+		BinaryPath:    binary,
+		GoVersion:     goToolchainVersion(ctx, encoreEnv.EncoreGoRoot()),
+		Attempts:      attempts,
+	}
+	res.recordUsage(state)
+	return res, nil
+}
+
+// buildStandaloneScript compiles the script's main package to binary
+// using Encore's Go toolchain, with a plain "go build".
+func buildStandaloneScript(ctx context.Context, p ExecScriptParams, binary string) error {
+	goroot := encoreEnv.EncoreGoRoot()
+	goBin := filepath.Join(goroot, "bin", "go")
+	if runtime.GOOS == "windows" {
+		goBin += ".exe"
+	}
+
+	args := []string{"build", "-o", binary}
+	if p.BuildVerbosity == BuildVerbose {
+		args = append(args, "-v", "-x")
+	}
+	if p.Race {
+		args = append(args, "-race")
+	}
+	if p.BuildParallelism > 0 {
+		args = append(args, "-p", strconv.Itoa(p.BuildParallelism))
+	}
+	args = append(args, p.MainPkg.String())
+
+	// nosemgrep: go.lang.security.audit.dangerous-exec-command.dangerous-exec-command
+	cmd := exec.CommandContext(ctx, goBin, args...)
+	cmd.Dir = p.App.Root()
+	cmd.Env = append(os.Environ(), p.Environ...)
+	cmd.Env = append(cmd.Env, "GOROOT="+goroot)
+	if dir, ok := p.TempDir.Get(); ok {
+		cmd.Env = append(cmd.Env, "GOTMPDIR="+dir)
+	}
+
+	// Report the build output only if the build fails,
+	// unless it's explicitly requested.
+	var out bytes.Buffer
+	if p.BuildVerbosity == BuildVerbose {
+		cmd.Stdout, cmd.Stderr = p.Stderr, p.Stderr
+	} else {
+		cmd.Stdout, cmd.Stderr = &out, &out
+	}
+	if err := cmd.Run(); err != nil {
+		if out.Len() == 0 {
+			return errors.Wrap(err, "compile error on exec")
+		}
+		return errors.Newf("compile error on exec:\n%s", bytes.TrimSpace(out.Bytes()))
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package run
+
+import (
+	"fmt"
+	"time"
+
+	"encr.dev/internal/optracker"
+)
+
+// buildHeartbeatInterval is how often startBuildHeartbeat reports that a build is still running.
+const buildHeartbeatInterval = 30 * time.Second
+
+// startBuildHeartbeat periodically reports that the script is still being built,
+// so slow builds aren't mistaken for being stuck. It reports through the OpTracker
+// if there is one, and otherwise to Stderr when the build is verbose.
+// The returned function stops it.
+func startBuildHeartbeat(p ExecScriptParams) (stop func()) {
+	if p.OpTracker == nil && p.BuildVerbosity != BuildVerbose {
+		return func() {}
+	}
+
+	start := time.Now()
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		ticker := time.NewTicker(buildHeartbeatInterval)
+		defer ticker.Stop()
+
+		op := optracker.NoOperationID
+		defer func() { p.OpTracker.Done(op, 0) }()
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				elapsed := now.Sub(start).Round(time.Second)
+				switch {
+				case p.OpTracker == nil:
+					fmt.Fprintf(p.Stderr, "Still building... %s\n", elapsed)
+				case op == optracker.NoOperationID:
+					op = p.OpTracker.Add(fmt.Sprintf("Still building (%s elapsed)", elapsed), now)
+				default:
+					p.OpTracker.Update(op, fmt.Sprintf("Still building (%s elapsed)", elapsed))
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-finished
+	}
+}
@@ -51,6 +51,10 @@ type ResourceManager struct {
 	log         zerolog.Logger
 	forTests    bool
 
+	// perDBOps, if set, reports the setup of each database
+	// as a separate operation. See ReportDatabaseSetup.
+	perDBOps bool
+
 	mutex   sync.Mutex
 	servers map[Type]Resource
 }
@@ -80,6 +84,13 @@ func (rm *ResourceManager) StopAll() {
 	}
 }
 
+// ReportDatabaseSetup makes StartRequiredServices report the setup and migration
+// of each database as a separate operation, instead of a single one for all of them,
+// so it's clear what's being provisioned.
+func (rm *ResourceManager) ReportDatabaseSetup() {
+	rm.perDBOps = true
+}
+
 type Resource interface {
 	// Stop shuts down the resource.
 	Stop()
@@ -190,6 +201,17 @@ func (rm *ResourceManager) StartSQLCluster(a *optracker.AsyncBuildJobs, md *meta
 				}
 				return nil
 			})
+		} else if rm.perDBOps {
+			for _, dbMeta := range md.SqlDatabases {
+				a.Go(fmt.Sprintf("Migrating database %s", dbMeta.Name), true, 250*time.Millisecond, func(ctx context.Context) error {
+					err := cluster.SetupAndMigrateDB(ctx, rm.app.Root(), dbMeta)
+					if err != nil {
+						rm.log.Error().Err(err).Str("db", dbMeta.Name).Msg("failed to setup db")
+						return err
+					}
+					return nil
+				})
+			}
 		} else {
 			a.Go("Running database migrations", true, 250*time.Millisecond, func(ctx context.Context) error {
 				err := cluster.SetupAndMigrate(ctx, rm.app.Root(), md)
@@ -0,0 +1,85 @@
+package run
+
+import (
+	"fmt"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// shutdownProgressInterval is how often the progress of stopping a script is reported.
+const shutdownProgressInterval = time.Second
+
+// handleShutdown configures cmd to be stopped gracefully if its context is canceled,
+// if p.ShutdownGrace is set: it's sent SIGTERM, and killed if it hasn't exited
+// after the grace period. Where SIGTERM isn't supported, it's killed right away.
+//
+// The shutdown is reported through the OpTracker if there is one, and otherwise
+// to Stderr when the build is verbose. The returned function must be called
+// once the script has exited.
+func handleShutdown(cmd *exec.Cmd, p ExecScriptParams) (exited func()) {
+	grace := p.ShutdownGrace
+	if grace <= 0 {
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	var reporting sync.WaitGroup
+	cmd.WaitDelay = grace
+	cmd.Cancel = func() error {
+		if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
+			return cmd.Process.Kill()
+		}
+		reporting.Add(1)
+		go func() {
+			defer reporting.Done()
+			reportShutdown(p, done)
+		}()
+		return nil
+	}
+	return func() {
+		close(done)
+		reporting.Wait()
+	}
+}
+
+// reportShutdown reports the progress of stopping the script towards the end
+// of its grace period, until done is closed.
+func reportShutdown(p ExecScriptParams, done <-chan struct{}) {
+	grace := p.ShutdownGrace
+	tracker := p.OpTracker
+	if tracker == nil && p.BuildVerbosity != BuildVerbose {
+		<-done
+		return
+	} else if tracker == nil {
+		fmt.Fprintf(p.Stderr, "Stopping script (killing it if it hasn't exited in %s)...\n", grace)
+		<-done
+		return
+	}
+
+	// The tracker was stopped when the script started writing output.
+	tracker.Reopen()
+	defer tracker.AllDone()
+
+	start := time.Now()
+	op := tracker.Add(fmt.Sprintf("Stopping script (%s grace period)", grace), start)
+	ticker := time.NewTicker(shutdownProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			if time.Since(start) >= grace {
+				tracker.Fail(op, errors.Newf("killed after the %s grace period", grace))
+			} else {
+				tracker.Done(op, 0)
+			}
+			return
+		case now := <-ticker.C:
+			elapsed := now.Sub(start).Round(time.Second)
+			tracker.Update(op, fmt.Sprintf("Stopping script (%s of %s grace period)", elapsed, grace))
+		}
+	}
+}
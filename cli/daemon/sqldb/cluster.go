@@ -283,6 +283,17 @@ func (c *Cluster) SetupAndMigrate(ctx context.Context, appRoot string, md *meta.
 	return g.Wait()
 }
 
+// SetupAndMigrateDB creates and migrates a single database.
+func (c *Cluster) SetupAndMigrateDB(ctx context.Context, appRoot string, dbMeta *meta.SQLDatabase) error {
+	c.mu.Lock()
+	db, ok := c.dbs[dbMeta.Name]
+	if !ok {
+		db = c.initDB(dbMeta.Name)
+	}
+	c.mu.Unlock()
+	return db.Setup(ctx, appRoot, dbMeta, true, false)
+}
+
 // GetDB gets the database with the given name.
 func (c *Cluster) GetDB(name string) (*DB, bool) {
 	c.mu.Lock()
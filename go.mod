@@ -16,6 +16,7 @@ require (
 	github.com/charmbracelet/lipgloss v0.7.1
 	github.com/cockroachdb/errors v1.11.1
 	github.com/containerd/stargz-snapshotter/estargz v0.14.3
+	github.com/creack/pty v1.1.9
 	github.com/dave/jennifer v1.7.0
 	github.com/evanw/esbuild v0.19.8
 	github.com/fatih/color v1.15.0
@@ -30,6 +30,7 @@ func New(w io.Writer, stream OutputStream) *OpTracker {
 type OpTracker struct {
 	mu          sync.Mutex
 	ops         []*slowOp
+	firstID     OperationID // the ID of ops[0]; operations added before Reopen have lower IDs
 	w           io.Writer
 	started     bool
 	quit        bool // quit indicates that the tracker has been stopped (this should only be set by AllDone)
@@ -69,6 +70,28 @@ func (t *OpTracker) AllDone() {
 	t.refresh()
 }
 
+// Reopen reactivates a tracker stopped by AllDone, for reporting operations
+// that happen after other output has been written, like stopping a script.
+// The operations added before are no longer displayed,
+// and calls with their IDs are ignored.
+//
+// This function is safe to call on a Nil OpTracker.
+func (t *OpTracker) Reopen() {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.quit {
+		return
+	}
+	t.firstID += OperationID(len(t.ops))
+	t.ops = nil
+	t.quit = false
+	t.savedCursor = sync.Once{} // display below the output written since
+}
+
 // Add creates a new item on the operations tracker returning the ID for that op.
 // minStart is the time at which the tracker will start to show the task as in progress.
 //
@@ -80,7 +103,7 @@ func (t *OpTracker) Add(msg string, minStart time.Time) OperationID {
 
 	t.mu.Lock()
 	defer t.mu.Unlock()
-	id := OperationID(len(t.ops))
+	id := t.firstID + OperationID(len(t.ops))
 
 	start := time.Now()
 	if start.Before(minStart) {
@@ -108,7 +131,10 @@ func (t *OpTracker) Done(id OperationID, minDuration time.Duration) {
 
 	t.mu.Lock()
 	defer t.mu.Unlock()
-	o := t.ops[id]
+	o := t.op(id)
+	if o == nil {
+		return
+	}
 
 	done := time.Now()
 	if a := o.start.Add(minDuration); a.After(done) {
@@ -118,6 +144,24 @@ func (t *OpTracker) Done(id OperationID, minDuration time.Duration) {
 	t.refresh()
 }
 
+// Update changes the message of the given operation.
+//
+// This function is safe to call on a Nil OpTracker and will no-op in that case
+func (t *OpTracker) Update(id OperationID, msg string) {
+	if t == nil || id == NoOperationID {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	o := t.op(id)
+	if o == nil {
+		return
+	}
+	o.msg = msg
+	t.refresh()
+}
+
 var ErrCanceled = errors.New("operation canceled")
 
 // Fail marks the operation as failed with the given error
@@ -130,11 +174,12 @@ func (t *OpTracker) Fail(id OperationID, err error) {
 
 	t.mu.Lock()
 	defer t.mu.Unlock()
-	if !t.ops[id].done.IsZero() {
+	o := t.op(id)
+	if o == nil || !o.done.IsZero() {
 		return
 	}
-	t.ops[id].err = err
-	t.ops[id].done = time.Now()
+	o.err = err
+	o.done = time.Now()
 	t.refresh()
 }
 
@@ -144,6 +189,16 @@ func (t *OpTracker) Cancel(id OperationID) {
 	t.Fail(id, ErrCanceled)
 }
 
+// op returns the operation with the given ID, or nil if there is no such
+// operation, like for an operation added before Reopen.
+// The mutex must be held by the caller.
+func (t *OpTracker) op(id OperationID) *slowOp {
+	if i := int(id - t.firstID); i >= 0 && i < len(t.ops) {
+		return t.ops[i]
+	}
+	return nil
+}
+
 // refresh refreshes the display by writing to t.w.
 // The mutex must be held by the caller.
 func (t *OpTracker) refresh() {
@@ -8,6 +8,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"regexp"
 
 	"github.com/tailscale/hujson"
 
@@ -60,6 +61,36 @@ type File struct {
 	//
 	// Deprecated: Use build.docker.base_image instead.
 	DockerBaseImage string `json:"docker_base_image,omitempty"`
+
+	// Commands are named commands that can be run with "encore exec <name>",
+	// instead of by the path to their package.
+	Commands []Command `json:"commands,omitempty"`
+}
+
+// Command is a named command, run with "encore exec".
+type Command struct {
+	// Name is the name of the command, like "seed-db".
+	Name string `json:"name"`
+
+	// Path is the slash-separated path to the command's main package,
+	// relative to the app root, like "cmd/seed".
+	Path string `json:"path"`
+
+	// Description optionally describes what the command does.
+	Description string `json:"description,omitempty"`
+}
+
+// commandNameRe matches valid command names.
+var commandNameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.:-]*$`)
+
+// Command returns the command with the given name, if any.
+func (f *File) Command(name string) (Command, bool) {
+	for _, c := range f.Commands {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return Command{}, false
 }
 
 type Build struct {
@@ -135,6 +166,19 @@ func Parse(data []byte) (*File, error) {
 		return nil, fmt.Errorf("appfile.Parse: invalid lang %q", f.Lang)
 	}
 
+	seen := make(map[string]bool, len(f.Commands))
+	for _, c := range f.Commands {
+		switch {
+		case !commandNameRe.MatchString(c.Name):
+			return nil, fmt.Errorf("appfile.Parse: invalid command name %q (must start with a letter or digit, followed by letters, digits, '_', '.', ':' or '-')", c.Name)
+		case seen[c.Name]:
+			return nil, fmt.Errorf("appfile.Parse: command %q is declared more than once", c.Name)
+		case c.Path == "" || !filepath.IsLocal(filepath.FromSlash(c.Path)):
+			return nil, fmt.Errorf("appfile.Parse: command %q: invalid path %q (must be a relative path within the app)", c.Name, c.Path)
+		}
+		seen[c.Name] = true
+	}
+
 	// Parse deprecated fields into the new Build struct.
 	f.Build.CgoEnabled = f.Build.CgoEnabled || f.CgoEnabled
 	if f.Build.Docker.BaseImage == "" {
@@ -0,0 +1,118 @@
+package appfile
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestParseCommands(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: `{"commands": [
+				{"name": "seed-db", "path": "cmd/seed"},
+				{"name": "db:migrate.v2_x", "path": "./tools/../cmd/migrate"},
+				{"name": "2fa", "path": "cmd/2fa", "description": "sets up 2fa"},
+			]}`,
+		},
+		{
+			name: "none",
+			data: `{}`,
+		},
+		{
+			name:    "empty_name",
+			data:    `{"commands": [{"name": "", "path": "cmd/seed"}]}`,
+			wantErr: `appfile.Parse: invalid command name "" \(must start with .*\)`,
+		},
+		{
+			name:    "leading_dash",
+			data:    `{"commands": [{"name": "-seed", "path": "cmd/seed"}]}`,
+			wantErr: `appfile.Parse: invalid command name "-seed" .*`,
+		},
+		{
+			name:    "space_in_name",
+			data:    `{"commands": [{"name": "seed db", "path": "cmd/seed"}]}`,
+			wantErr: `appfile.Parse: invalid command name "seed db" .*`,
+		},
+		{
+			name:    "slash_in_name",
+			data:    `{"commands": [{"name": "cmd/seed", "path": "cmd/seed"}]}`,
+			wantErr: `appfile.Parse: invalid command name "cmd/seed" .*`,
+		},
+		{
+			name: "duplicate_name",
+			data: `{"commands": [
+				{"name": "seed", "path": "cmd/seed"},
+				{"name": "seed", "path": "cmd/other"},
+			]}`,
+			wantErr: `appfile.Parse: command "seed" is declared more than once`,
+		},
+		{
+			name:    "empty_path",
+			data:    `{"commands": [{"name": "seed", "path": ""}]}`,
+			wantErr: `appfile.Parse: command "seed": invalid path "" \(must be a relative path within the app\)`,
+		},
+		{
+			name:    "absolute_path",
+			data:    `{"commands": [{"name": "seed", "path": "/cmd/seed"}]}`,
+			wantErr: `appfile.Parse: command "seed": invalid path "/cmd/seed" .*`,
+		},
+		{
+			name:    "parent_path",
+			data:    `{"commands": [{"name": "seed", "path": "../cmd/seed"}]}`,
+			wantErr: `appfile.Parse: command "seed": invalid path "../cmd/seed" .*`,
+		},
+		{
+			name:    "nested_parent_path",
+			data:    `{"commands": [{"name": "seed", "path": "cmd/../../seed"}]}`,
+			wantErr: `appfile.Parse: command "seed": invalid path "cmd/../../seed" .*`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := qt.New(t)
+			f, err := Parse([]byte(test.data))
+			if test.wantErr != "" {
+				c.Assert(err, qt.ErrorMatches, test.wantErr)
+				return
+			}
+			c.Assert(err, qt.IsNil)
+			c.Assert(f.Lang, qt.Equals, LangGo)
+		})
+	}
+}
+
+func TestFileCommand(t *testing.T) {
+	c := qt.New(t)
+	f, err := Parse([]byte(`{"commands": [
+		{"name": "seed", "path": "cmd/seed", "description": "seeds the database"},
+		{"name": "migrate", "path": "cmd/migrate"},
+	]}`))
+	c.Assert(err, qt.IsNil)
+
+	tests := []struct {
+		name   string
+		want   Command
+		wantOk bool
+	}{
+		{name: "seed", want: Command{Name: "seed", Path: "cmd/seed", Description: "seeds the database"}, wantOk: true},
+		{name: "migrate", want: Command{Name: "migrate", Path: "cmd/migrate"}, wantOk: true},
+		{name: "Seed"},
+		{name: "cmd/seed"},
+		{name: ""},
+	}
+	for _, test := range tests {
+		got, ok := f.Command(test.name)
+		c.Assert(ok, qt.Equals, test.wantOk, qt.Commentf("name %q", test.name))
+		c.Assert(got, qt.Equals, test.want, qt.Commentf("name %q", test.name))
+	}
+
+	// A file without commands, like a missing app file, has none.
+	_, ok := (&File{}).Command("seed")
+	c.Assert(ok, qt.IsFalse)
+}
@@ -42,6 +42,10 @@ type BuildInfo struct {
 	// MainPkg is the path to the existing main package to use, if any.
 	MainPkg option.Option[paths.Pkg]
 
+	// ExecScriptEntrypoint, if set, is the name of an exported function in MainPkg
+	// to run as the program, instead of MainPkg being a main package.
+	ExecScriptEntrypoint option.Option[string]
+
 	// Overrides to explicitly set the GoRoot and EncoreRuntime paths.
 	// if not set, they will be inferred from the current executable.
 	GoRoot         option.Option[paths.FS]
@@ -51,6 +55,16 @@ type BuildInfo struct {
 	// with the local JS runtime.
 	UseLocalJSRuntime bool
 
+	// GoBuildFlags are additional flags to pass to "go build".
+	GoBuildFlags []string
+
+	// GoBuildLog, if set, receives the output of "go build" as it runs.
+	GoBuildLog option.Option[io.Writer]
+
+	// TempDir, if set, is the directory to stage the build in,
+	// including the Go toolchain's own temporary files.
+	TempDir option.Option[string]
+
 	// Logger allows a custom logger to be used by the various phases of the builder.
 	Logger option.Option[zerolog.Logger]
 }
@@ -8,12 +8,20 @@ import (
 	"os"
 	"os/exec"
 	"os/user"
+	"runtime"
+	"strconv"
 	"syscall"
 
 	"github.com/cockroachdb/errors"
+	"github.com/creack/pty"
 	"github.com/google/renameio/v2"
+	"golang.org/x/sys/unix"
 )
 
+// ErrPTYUnsupported is reported by StartPTY when pseudo-terminals
+// aren't supported on the current platform.
+var ErrPTYUnsupported = errors.New("pseudo-terminals are not supported on this platform")
+
 func CreateNewProcessGroup() *syscall.SysProcAttr {
 	return &syscall.SysProcAttr{Setpgid: true}
 }
@@ -33,6 +41,69 @@ func ArrangeExtraFiles(cmd *exec.Cmd, files ...*os.File) error {
 	return nil
 }
 
+// RunAsUser configures cmd to run as the OS user with the given username or uid,
+// using the user's primary and supplementary groups.
+// Starting the command fails if the current process lacks the privileges to switch user.
+func RunAsUser(cmd *exec.Cmd, username string) error {
+	uid, gid, groups, err := lookupUser(username)
+	if err != nil {
+		return err
+	}
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Credential = &syscall.Credential{
+		Uid:    uid,
+		Gid:    gid,
+		Groups: groups,
+	}
+	return nil
+}
+
+// ChownToUser changes the owner of the file at path to the OS user with the
+// given username or uid, and its group to the user's primary group,
+// for files that a command run with RunAsUser must be able to write.
+func ChownToUser(path, username string) error {
+	uid, gid, _, err := lookupUser(username)
+	if err != nil {
+		return err
+	}
+	return errors.WithStack(os.Chown(path, int(uid), int(gid)))
+}
+
+// lookupUser looks up the uid, primary gid and supplementary groups
+// of the OS user with the given username or uid.
+func lookupUser(username string) (uid, gid uint32, groups []uint32, err error) {
+	usr, err := user.Lookup(username)
+	if err != nil {
+		var unknown user.UnknownUserError
+		if !errors.As(err, &unknown) {
+			return 0, 0, nil, err
+		}
+		if usr, err = user.LookupId(username); err != nil {
+			return 0, 0, nil, errors.Newf("unknown user %q", username)
+		}
+	}
+
+	u, err := strconv.ParseUint(usr.Uid, 10, 32)
+	if err != nil {
+		return 0, 0, nil, errors.Wrapf(err, "invalid uid for user %q", username)
+	}
+	g, err := strconv.ParseUint(usr.Gid, 10, 32)
+	if err != nil {
+		return 0, 0, nil, errors.Wrapf(err, "invalid gid for user %q", username)
+	}
+
+	if groupIDs, err := usr.GroupIds(); err == nil {
+		for _, id := range groupIDs {
+			if id, err := strconv.ParseUint(id, 10, 32); err == nil {
+				groups = append(groups, uint32(id))
+			}
+		}
+	}
+	return uint32(u), uint32(g), groups, nil
+}
+
 func IsAdminUser() (bool, error) {
 	usr, err := user.Current()
 	if err != nil {
@@ -49,3 +120,56 @@ func IsAdminUser() (bool, error) {
 func WriteFile(filename string, data []byte, perm os.FileMode) error {
 	return errors.WithStack(renameio.WriteFile(filename, data, perm))
 }
+
+// PeakMemory reports the peak resident set size of the exited process, in bytes.
+// It reports false if the platform doesn't provide it.
+func PeakMemory(state *os.ProcessState) (uint64, bool) {
+	rusage, ok := state.SysUsage().(*syscall.Rusage)
+	if !ok || rusage.Maxrss <= 0 {
+		return 0, false
+	}
+	// ru_maxrss is in bytes on Apple platforms, and in kilobytes elsewhere.
+	if runtime.GOOS == "darwin" || runtime.GOOS == "ios" {
+		return uint64(rusage.Maxrss), true
+	}
+	return uint64(rusage.Maxrss) * 1024, true
+}
+
+// CanExecute reports whether the file at path can be executed by the current user.
+// It's false for files on filesystems mounted with noexec, regardless of the file's mode.
+func CanExecute(path string) bool {
+	return unix.Access(path, unix.X_OK) == nil
+}
+
+// StartPTY starts cmd with a new pseudo-terminal of the given size as its
+// standard input, output and error, and returns the terminal's controlling end.
+// Reading from it yields the command's output, and writes to it are the command's input.
+// The caller is responsible for closing it.
+func StartPTY(cmd *exec.Cmd, rows, cols uint16) (*os.File, error) {
+	ptmx, tty, err := pty.Open()
+	if errors.Is(err, pty.ErrUnsupported) {
+		return nil, ErrPTYUnsupported
+	} else if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tty.Close() }()
+	if err := pty.Setsize(ptmx, &pty.Winsize{Rows: rows, Cols: cols}); err != nil {
+		_ = ptmx.Close()
+		return nil, err
+	}
+
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = tty, tty, tty
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	// Make the terminal the controlling terminal of a new session.
+	// Ctty refers to the file descriptor in the child, which is its standard input.
+	cmd.SysProcAttr.Setsid = true
+	cmd.SysProcAttr.Setctty = true
+	cmd.SysProcAttr.Ctty = 0
+	if err := cmd.Start(); err != nil {
+		_ = ptmx.Close()
+		return nil, err
+	}
+	return ptmx, nil
+}
@@ -15,6 +15,10 @@ import (
 	"golang.org/x/sys/windows"
 )
 
+// ErrPTYUnsupported is reported by StartPTY when pseudo-terminals
+// aren't supported on the current platform.
+var ErrPTYUnsupported = errors.New("pseudo-terminals are not supported on this platform")
+
 func CreateNewProcessGroup() *syscall.SysProcAttr {
 	return &syscall.SysProcAttr{
 		CreationFlags: windows.CREATE_NEW_PROCESS_GROUP,
@@ -68,6 +72,18 @@ func ArrangeExtraFiles(cmd *exec.Cmd, files ...*os.File) error {
 	return nil
 }
 
+// RunAsUser configures cmd to run as the OS user with the given username.
+// It's not supported on Windows.
+func RunAsUser(cmd *exec.Cmd, username string) error {
+	return errors.New("running commands as a different user is not supported on Windows")
+}
+
+// ChownToUser changes the owner of the file at path to the OS user with the given username.
+// It's not supported on Windows.
+func ChownToUser(path, username string) error {
+	return errors.New("running commands as a different user is not supported on Windows")
+}
+
 func IsAdminUser() (bool, error) {
 	// For Windows we elevate permissions on demand, so pretend we are admin
 	return true, nil
@@ -81,3 +97,21 @@ func IsAdminUser() (bool, error) {
 func WriteFile(filename string, data []byte, perm os.FileMode) error {
 	return errors.WithStack(os.WriteFile(filename, data, perm))
 }
+
+// PeakMemory reports the peak resident set size of the exited process, in bytes.
+// It's not available on Windows, and always reports false.
+func PeakMemory(state *os.ProcessState) (uint64, bool) {
+	return 0, false
+}
+
+// CanExecute reports whether the file at path can be executed by the current user.
+// Windows has no notion of executable permissions, so it always reports true.
+func CanExecute(path string) bool {
+	return true
+}
+
+// StartPTY starts cmd with a new pseudo-terminal as its standard input, output and error.
+// It's not supported on Windows, and always reports ErrPTYUnsupported.
+func StartPTY(cmd *exec.Cmd, rows, cols uint16) (*os.File, error) {
+	return nil, ErrPTYUnsupported
+}
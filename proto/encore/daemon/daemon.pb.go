@@ -9,7 +9,9 @@ package daemon
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -119,7 +121,60 @@ func (x RunRequest_BrowserMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RunRequest_BrowserMode.Descriptor instead.
 func (RunRequest_BrowserMode) EnumDescriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{4, 0}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type ExecScriptRequest_BuildVerbosity int32
+
+const (
+	// BUILD_VERBOSITY_NORMAL reports build progress and errors.
+	ExecScriptRequest_BUILD_VERBOSITY_NORMAL ExecScriptRequest_BuildVerbosity = 0
+	// BUILD_VERBOSITY_QUIET only reports build errors.
+	ExecScriptRequest_BUILD_VERBOSITY_QUIET ExecScriptRequest_BuildVerbosity = 1
+	// BUILD_VERBOSITY_VERBOSE streams the output of the Go build
+	// in verbose mode (with -v and -x).
+	ExecScriptRequest_BUILD_VERBOSITY_VERBOSE ExecScriptRequest_BuildVerbosity = 2
+)
+
+// Enum value maps for ExecScriptRequest_BuildVerbosity.
+var (
+	ExecScriptRequest_BuildVerbosity_name = map[int32]string{
+		0: "BUILD_VERBOSITY_NORMAL",
+		1: "BUILD_VERBOSITY_QUIET",
+		2: "BUILD_VERBOSITY_VERBOSE",
+	}
+	ExecScriptRequest_BuildVerbosity_value = map[string]int32{
+		"BUILD_VERBOSITY_NORMAL":  0,
+		"BUILD_VERBOSITY_QUIET":   1,
+		"BUILD_VERBOSITY_VERBOSE": 2,
+	}
+)
+
+func (x ExecScriptRequest_BuildVerbosity) Enum() *ExecScriptRequest_BuildVerbosity {
+	p := new(ExecScriptRequest_BuildVerbosity)
+	*p = x
+	return p
+}
+
+func (x ExecScriptRequest_BuildVerbosity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecScriptRequest_BuildVerbosity) Descriptor() protoreflect.EnumDescriptor {
+	return file_encore_daemon_daemon_proto_enumTypes[2].Descriptor()
+}
+
+func (ExecScriptRequest_BuildVerbosity) Type() protoreflect.EnumType {
+	return &file_encore_daemon_daemon_proto_enumTypes[2]
+}
+
+func (x ExecScriptRequest_BuildVerbosity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecScriptRequest_BuildVerbosity.Descriptor instead.
+func (ExecScriptRequest_BuildVerbosity) EnumDescriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{11, 0}
 }
 
 type DumpMetaRequest_Format int32
@@ -155,11 +210,11 @@ func (x DumpMetaRequest_Format) String() string {
 }
 
 func (DumpMetaRequest_Format) Descriptor() protoreflect.EnumDescriptor {
-	return file_encore_daemon_daemon_proto_enumTypes[2].Descriptor()
+	return file_encore_daemon_daemon_proto_enumTypes[3].Descriptor()
 }
 
 func (DumpMetaRequest_Format) Type() protoreflect.EnumType {
-	return &file_encore_daemon_daemon_proto_enumTypes[2]
+	return &file_encore_daemon_daemon_proto_enumTypes[3]
 }
 
 func (x DumpMetaRequest_Format) Number() protoreflect.EnumNumber {
@@ -168,7 +223,7 @@ func (x DumpMetaRequest_Format) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DumpMetaRequest_Format.Descriptor instead.
 func (DumpMetaRequest_Format) EnumDescriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{30, 0}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{37, 0}
 }
 
 type CommandMessage struct {
@@ -181,6 +236,7 @@ type CommandMessage struct {
 	//	*CommandMessage_Output
 	//	*CommandMessage_Exit
 	//	*CommandMessage_Errors
+	//	*CommandMessage_Artifact
 	Msg isCommandMessage_Msg `protobuf_oneof:"msg"`
 }
 
@@ -244,6 +300,13 @@ func (x *CommandMessage) GetErrors() *CommandDisplayErrors {
 	return nil
 }
 
+func (x *CommandMessage) GetArtifact() *CommandArtifact {
+	if x, ok := x.GetMsg().(*CommandMessage_Artifact); ok {
+		return x.Artifact
+	}
+	return nil
+}
+
 type isCommandMessage_Msg interface {
 	isCommandMessage_Msg()
 }
@@ -260,12 +323,18 @@ type CommandMessage_Errors struct {
 	Errors *CommandDisplayErrors `protobuf:"bytes,3,opt,name=errors,proto3,oneof"`
 }
 
+type CommandMessage_Artifact struct {
+	Artifact *CommandArtifact `protobuf:"bytes,4,opt,name=artifact,proto3,oneof"`
+}
+
 func (*CommandMessage_Output) isCommandMessage_Msg() {}
 
 func (*CommandMessage_Exit) isCommandMessage_Msg() {}
 
 func (*CommandMessage_Errors) isCommandMessage_Msg() {}
 
+func (*CommandMessage_Artifact) isCommandMessage_Msg() {}
+
 type CommandOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -273,6 +342,9 @@ type CommandOutput struct {
 
 	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
 	Stderr []byte `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// seq is the sequence number of the output, for resumable scripts.
+	// It can be passed to ExecScriptResume to replay output after a disconnect.
+	Seq uint64 `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
 func (x *CommandOutput) Reset() {
@@ -321,12 +393,22 @@ func (x *CommandOutput) GetStderr() []byte {
 	return nil
 }
 
+func (x *CommandOutput) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
 type CommandExit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Code int32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"` // exit code
+	// exec_result describes the result of a successful ExecScript call.
+	// It's only set for ExecScript.
+	ExecResult *ExecScriptResult `protobuf:"bytes,2,opt,name=exec_result,json=execResult,proto3,oneof" json:"exec_result,omitempty"`
 }
 
 func (x *CommandExit) Reset() {
@@ -368,6 +450,187 @@ func (x *CommandExit) GetCode() int32 {
 	return 0
 }
 
+func (x *CommandExit) GetExecResult() *ExecScriptResult {
+	if x != nil {
+		return x.ExecResult
+	}
+	return nil
+}
+
+type ExecScriptResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// build_duration is how long it took to compile the script.
+	BuildDuration *durationpb.Duration `protobuf:"bytes,1,opt,name=build_duration,json=buildDuration,proto3" json:"build_duration,omitempty"`
+	// binary_path is the path to the compiled script binary. It no longer exists
+	// if it was built in a temporary directory, unless ExecScriptRequest.keep_binary is set.
+	BinaryPath string `protobuf:"bytes,2,opt,name=binary_path,json=binaryPath,proto3" json:"binary_path,omitempty"`
+	// go_version is the version of the Go toolchain that compiled the script,
+	// like "go1.22.2". It's empty if the script wasn't compiled with Go.
+	GoVersion string `protobuf:"bytes,3,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	// cpu_time is the total user and system CPU time used by the script process.
+	CpuTime *durationpb.Duration `protobuf:"bytes,4,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	// peak_memory_bytes is the peak resident set size of the script process,
+	// in bytes. It's not set if the platform doesn't report it.
+	PeakMemoryBytes *uint64 `protobuf:"varint,5,opt,name=peak_memory_bytes,json=peakMemoryBytes,proto3,oneof" json:"peak_memory_bytes,omitempty"`
+	// attempts is the number of times the script was run, including retries.
+	Attempts uint32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (x *ExecScriptResult) Reset() {
+	*x = ExecScriptResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encore_daemon_daemon_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecScriptResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecScriptResult) ProtoMessage() {}
+
+func (x *ExecScriptResult) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecScriptResult.ProtoReflect.Descriptor instead.
+func (*ExecScriptResult) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExecScriptResult) GetBuildDuration() *durationpb.Duration {
+	if x != nil {
+		return x.BuildDuration
+	}
+	return nil
+}
+
+func (x *ExecScriptResult) GetBinaryPath() string {
+	if x != nil {
+		return x.BinaryPath
+	}
+	return ""
+}
+
+func (x *ExecScriptResult) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *ExecScriptResult) GetCpuTime() *durationpb.Duration {
+	if x != nil {
+		return x.CpuTime
+	}
+	return nil
+}
+
+func (x *ExecScriptResult) GetPeakMemoryBytes() uint64 {
+	if x != nil && x.PeakMemoryBytes != nil {
+		return *x.PeakMemoryBytes
+	}
+	return 0
+}
+
+func (x *ExecScriptResult) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+// CommandArtifact is a chunk of a file created by a script in its artifact directory
+// (see ExecScriptRequest.collect_artifacts). Each file is sent as one or more
+// consecutive chunks, the last of which has eof set.
+type CommandArtifact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// path is the slash-separated path of the file, relative to the artifact directory.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// data is the next chunk of the file's contents.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// mode is the file's permission bits.
+	Mode uint32 `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	// eof is set on the last chunk of the file.
+	Eof bool `protobuf:"varint,4,opt,name=eof,proto3" json:"eof,omitempty"`
+}
+
+func (x *CommandArtifact) Reset() {
+	*x = CommandArtifact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encore_daemon_daemon_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandArtifact) ProtoMessage() {}
+
+func (x *CommandArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandArtifact.ProtoReflect.Descriptor instead.
+func (*CommandArtifact) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CommandArtifact) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CommandArtifact) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CommandArtifact) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *CommandArtifact) GetEof() bool {
+	if x != nil {
+		return x.Eof
+	}
+	return false
+}
+
 type CommandDisplayErrors struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -379,7 +642,7 @@ type CommandDisplayErrors struct {
 func (x *CommandDisplayErrors) Reset() {
 	*x = CommandDisplayErrors{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[3]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -392,7 +655,7 @@ func (x *CommandDisplayErrors) String() string {
 func (*CommandDisplayErrors) ProtoMessage() {}
 
 func (x *CommandDisplayErrors) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[3]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,7 +668,7 @@ func (x *CommandDisplayErrors) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommandDisplayErrors.ProtoReflect.Descriptor instead.
 func (*CommandDisplayErrors) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{3}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CommandDisplayErrors) GetErrinsrc() []byte {
@@ -447,7 +710,7 @@ type RunRequest struct {
 func (x *RunRequest) Reset() {
 	*x = RunRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[4]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -460,7 +723,7 @@ func (x *RunRequest) String() string {
 func (*RunRequest) ProtoMessage() {}
 
 func (x *RunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[4]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +736,7 @@ func (x *RunRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunRequest.ProtoReflect.Descriptor instead.
 func (*RunRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{4}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RunRequest) GetAppRoot() string {
@@ -560,7 +823,7 @@ type TestRequest struct {
 func (x *TestRequest) Reset() {
 	*x = TestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[5]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -573,7 +836,7 @@ func (x *TestRequest) String() string {
 func (*TestRequest) ProtoMessage() {}
 
 func (x *TestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[5]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +849,7 @@ func (x *TestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestRequest.ProtoReflect.Descriptor instead.
 func (*TestRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{5}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TestRequest) GetAppRoot() string {
@@ -647,7 +910,7 @@ type TestSpecRequest struct {
 func (x *TestSpecRequest) Reset() {
 	*x = TestSpecRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[6]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -660,7 +923,650 @@ func (x *TestSpecRequest) String() string {
 func (*TestSpecRequest) ProtoMessage() {}
 
 func (x *TestSpecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[6]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSpecRequest.ProtoReflect.Descriptor instead.
+func (*TestSpecRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TestSpecRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *TestSpecRequest) GetWorkingDir() string {
+	if x != nil {
+		return x.WorkingDir
+	}
+	return ""
+}
+
+func (x *TestSpecRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *TestSpecRequest) GetEnviron() []string {
+	if x != nil {
+		return x.Environ
+	}
+	return nil
+}
+
+type TestSpecResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command string   `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Args    []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	Environ []string `protobuf:"bytes,3,rep,name=environ,proto3" json:"environ,omitempty"`
+}
+
+func (x *TestSpecResponse) Reset() {
+	*x = TestSpecResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encore_daemon_daemon_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestSpecResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSpecResponse) ProtoMessage() {}
+
+func (x *TestSpecResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSpecResponse.ProtoReflect.Descriptor instead.
+func (*TestSpecResponse) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TestSpecResponse) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *TestSpecResponse) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *TestSpecResponse) GetEnviron() []string {
+	if x != nil {
+		return x.Environ
+	}
+	return nil
+}
+
+// TerminalSize is the size of a terminal, in characters.
+type TerminalSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows uint32 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols uint32 `protobuf:"varint,2,opt,name=cols,proto3" json:"cols,omitempty"`
+}
+
+func (x *TerminalSize) Reset() {
+	*x = TerminalSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encore_daemon_daemon_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminalSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalSize) ProtoMessage() {}
+
+func (x *TerminalSize) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalSize.ProtoReflect.Descriptor instead.
+func (*TerminalSize) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TerminalSize) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *TerminalSize) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+type ExecScriptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppRoot    string `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	WorkingDir string `protobuf:"bytes,2,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
+	// command_rel_path is the slash-separated relative path to the command to run,
+	// from the app root.
+	CommandRelPath string   `protobuf:"bytes,3,opt,name=command_rel_path,json=commandRelPath,proto3" json:"command_rel_path,omitempty"`
+	ScriptArgs     []string `protobuf:"bytes,4,rep,name=script_args,json=scriptArgs,proto3" json:"script_args,omitempty"`
+	// environ is the environment to set for the running command.
+	// Each entry is a string in the format "KEY=VALUE", identical to os.Environ().
+	Environ []string `protobuf:"bytes,5,rep,name=environ,proto3" json:"environ,omitempty"`
+	// trace_file, if set specifies a trace file to write trace information
+	// about the parse and compilation process to.
+	TraceFile *string `protobuf:"bytes,6,opt,name=trace_file,json=traceFile,proto3,oneof" json:"trace_file,omitempty"`
+	// namespace is the infrastructure namespace to use.
+	// If empty the active namespace is used.
+	Namespace *string `protobuf:"bytes,7,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	// dry_run, if true, reports what would be executed
+	// without building or running the script.
+	DryRun bool `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// run_all_commands, if true, runs every main package located within
+	// a "cmd" directory in the app, sequentially, instead of the single
+	// command given by command_rel_path.
+	RunAllCommands bool `protobuf:"varint,9,opt,name=run_all_commands,json=runAllCommands,proto3" json:"run_all_commands,omitempty"`
+	// build_verbosity controls the amount of build output to stream.
+	BuildVerbosity ExecScriptRequest_BuildVerbosity `protobuf:"varint,10,opt,name=build_verbosity,json=buildVerbosity,proto3,enum=encore.daemon.ExecScriptRequest_BuildVerbosity" json:"build_verbosity,omitempty"`
+	// run_as_user, if set, is the OS user (name or uid) to run the script as.
+	// It's only supported on Unix, and requires the daemon to have
+	// the privileges to switch user.
+	RunAsUser *string `protobuf:"bytes,11,opt,name=run_as_user,json=runAsUser,proto3,oneof" json:"run_as_user,omitempty"`
+	// keep_binary, if true, keeps the compiled script binary after the script exits
+	// when it's built in a temporary directory, which is otherwise deleted.
+	KeepBinary bool `protobuf:"varint,12,opt,name=keep_binary,json=keepBinary,proto3" json:"keep_binary,omitempty"`
+	// context_values are key-value pairs made available to the script
+	// through encore.ScriptContextValues(), for example to identify an acting user.
+	ContextValues map[string]string `protobuf:"bytes,13,rep,name=context_values,json=contextValues,proto3" json:"context_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// secret_overrides are secret values to use for this execution only,
+	// keyed by secret name. They take precedence over the app's local secrets
+	// and are never persisted.
+	SecretOverrides map[string]string `protobuf:"bytes,14,rep,name=secret_overrides,json=secretOverrides,proto3" json:"secret_overrides,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// standalone, if true, builds only the command's package as a plain Go program,
+	// without parsing the app or generating Encore code. It's faster, but the script
+	// cannot access any Encore resources (databases, secrets, API calls, etc.).
+	Standalone bool `protobuf:"varint,15,opt,name=standalone,proto3" json:"standalone,omitempty"`
+	// cleanup_command_rel_path, if set, is the slash-separated relative path from the app root
+	// to a command to run after the script exits, regardless of whether it succeeded.
+	// It's meant for cleaning up resources the script created.
+	CleanupCommandRelPath *string `protobuf:"bytes,16,opt,name=cleanup_command_rel_path,json=cleanupCommandRelPath,proto3,oneof" json:"cleanup_command_rel_path,omitempty"`
+	// cleanup_args are the arguments to pass to the cleanup command.
+	CleanupArgs []string `protobuf:"bytes,17,rep,name=cleanup_args,json=cleanupArgs,proto3" json:"cleanup_args,omitempty"`
+	// resume_id, if set, makes the script resumable: it keeps running if the
+	// client disconnects, and its recent output is buffered so a client can
+	// reattach using ExecScriptResume with the same id.
+	ResumeId *string `protobuf:"bytes,18,opt,name=resume_id,json=resumeId,proto3,oneof" json:"resume_id,omitempty"`
+	// session_id groups related script executions, such as the steps of a
+	// multi-step maintenance operation, in logs and traces: it's added to the
+	// script's log records, and used as the correlation id of the API calls
+	// the script makes. If unset, the daemon generates one and reports it.
+	SessionId *string `protobuf:"bytes,19,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	// detach, if true, starts the script in the background: the daemon replies
+	// with the script's resume id as soon as it has started, without streaming
+	// its output, which can be streamed later using ExecScriptResume.
+	// If resume_id is unset, the daemon generates one.
+	Detach bool `protobuf:"varint,20,opt,name=detach,proto3" json:"detach,omitempty"`
+	// race, if true, builds the script with the Go race detector enabled.
+	// Race reports are written to the script's standard error.
+	Race bool `protobuf:"varint,21,opt,name=race,proto3" json:"race,omitempty"`
+	// max_output_bytes, if non-zero, is the maximum total size of the output
+	// the script may produce. Output beyond it is dropped, after a marker
+	// saying the output was truncated.
+	MaxOutputBytes uint64 `protobuf:"varint,22,opt,name=max_output_bytes,json=maxOutputBytes,proto3" json:"max_output_bytes,omitempty"`
+	// kill_on_max_output, if true, terminates the script
+	// when it exceeds max_output_bytes.
+	KillOnMaxOutput bool `protobuf:"varint,23,opt,name=kill_on_max_output,json=killOnMaxOutput,proto3" json:"kill_on_max_output,omitempty"`
+	// tty, if set, runs the script in a pseudo-terminal of the given size,
+	// for scripts that render differently when attached to a terminal
+	// (colors, prompts, progress bars). The script's standard output and error
+	// are then streamed together as standard output. Where pseudo-terminals
+	// aren't supported, the script runs without one.
+	Tty *TerminalSize `protobuf:"bytes,24,opt,name=tty,proto3,oneof" json:"tty,omitempty"`
+	// build_parallelism, if non-zero, is the number of programs, such as build
+	// commands, the Go toolchain may run in parallel (its -p flag).
+	// It defaults to the number of CPUs available.
+	BuildParallelism uint32 `protobuf:"varint,25,opt,name=build_parallelism,json=buildParallelism,proto3" json:"build_parallelism,omitempty"`
+	// entrypoint, if set, is the name of an exported function in the command's
+	// package to run as the script, instead of the package being a main package.
+	// It must have one of the signatures func(), func() error,
+	// func(context.Context) or func(context.Context) error.
+	// A main package calling it is generated when building the script.
+	Entrypoint *string `protobuf:"bytes,26,opt,name=entrypoint,proto3,oneof" json:"entrypoint,omitempty"`
+	// temp_dir, if set, is the absolute path of the directory to stage
+	// the script's build in, instead of the system's temporary directory.
+	// It must be writable and allow executing files, since the compiled
+	// script binary is run from it.
+	TempDir *string `protobuf:"bytes,27,opt,name=temp_dir,json=tempDir,proto3,oneof" json:"temp_dir,omitempty"`
+	// timezone, if set, is the IANA time zone to run the script in, like
+	// "Europe/Stockholm". It's set as the TZ environment variable of the script.
+	Timezone *string `protobuf:"bytes,28,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	// locale, if set, is the locale to run the script in, like "sv_SE.UTF-8".
+	// It's set as the LANG environment variable of the script.
+	Locale *string `protobuf:"bytes,29,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// script_app_root, if set, is the absolute path to the root of a sibling
+	// Encore app that defines the script, for example a shared tooling app
+	// in the same repository. The command paths are resolved relative to it,
+	// and the script runs from its root, but against the namespace of the
+	// app at app_root.
+	ScriptAppRoot *string `protobuf:"bytes,30,opt,name=script_app_root,json=scriptAppRoot,proto3,oneof" json:"script_app_root,omitempty"`
+	// max_retries is the number of times to re-run the script if it exits
+	// with a non-zero status, for scripts that may fail transiently.
+	// Build failures aren't retried. Each retry is preceded by a marker
+	// in the script's standard error.
+	MaxRetries uint32 `protobuf:"varint,31,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// backoff_seconds is how long to wait before the first retry, in seconds.
+	// It's doubled for each subsequent retry.
+	BackoffSeconds uint32 `protobuf:"varint,32,opt,name=backoff_seconds,json=backoffSeconds,proto3" json:"backoff_seconds,omitempty"`
+	// shutdown_grace_seconds, if non-zero, is how long the script has to exit
+	// after being sent SIGTERM when it's canceled, before it's killed.
+	// The shutdown is reported as a tracked operation. If zero, the script
+	// is killed right away.
+	ShutdownGraceSeconds uint32 `protobuf:"varint,33,opt,name=shutdown_grace_seconds,json=shutdownGraceSeconds,proto3" json:"shutdown_grace_seconds,omitempty"`
+	// ephemeral_namespace, if true, runs the script against a new, empty
+	// namespace created for the run and deleted afterwards, so it doesn't touch
+	// the data of any existing namespace. Its name is reported before the script
+	// runs. It cannot be combined with namespace.
+	EphemeralNamespace bool `protobuf:"varint,34,opt,name=ephemeral_namespace,json=ephemeralNamespace,proto3" json:"ephemeral_namespace,omitempty"`
+	// min_log_level, if set, is the minimum level of the structured log records
+	// written by the script to stream, like "info" to drop debug and trace records.
+	// Structured log records are lines with a JSON object with a level, as written
+	// by the Encore runtime. Other output is streamed regardless.
+	MinLogLevel *string `protobuf:"bytes,35,opt,name=min_log_level,json=minLogLevel,proto3,oneof" json:"min_log_level,omitempty"`
+	// command_name, if set, is the command argument as given by the user. If it's
+	// the name of a command declared in the app's encore.app file, that command is
+	// run instead of the one at command_rel_path. It's an error if command_rel_path
+	// is also a directory, other than the command's own.
+	CommandName *string `protobuf:"bytes,36,opt,name=command_name,json=commandName,proto3,oneof" json:"command_name,omitempty"`
+	// collect_artifacts, if true, gives the script an empty directory to write files
+	// to, such as reports or exports, in the ENCORE_ARTIFACT_DIR environment variable.
+	// Once the script and any cleanup command have exited, the files written there are
+	// sent as artifact messages, before the exit message, and the directory is deleted.
+	CollectArtifacts bool `protobuf:"varint,37,opt,name=collect_artifacts,json=collectArtifacts,proto3" json:"collect_artifacts,omitempty"`
+	// log_resource_access, if true, makes the script's Encore runtime log every
+	// database query, Pub/Sub publish and cache operation it performs, as debug-level
+	// structured log records streamed with the rest of its output.
+	LogResourceAccess bool `protobuf:"varint,38,opt,name=log_resource_access,json=logResourceAccess,proto3" json:"log_resource_access,omitempty"`
+}
+
+func (x *ExecScriptRequest) Reset() {
+	*x = ExecScriptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encore_daemon_daemon_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecScriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecScriptRequest) ProtoMessage() {}
+
+func (x *ExecScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecScriptRequest.ProtoReflect.Descriptor instead.
+func (*ExecScriptRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ExecScriptRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetWorkingDir() string {
+	if x != nil {
+		return x.WorkingDir
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetCommandRelPath() string {
+	if x != nil {
+		return x.CommandRelPath
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetScriptArgs() []string {
+	if x != nil {
+		return x.ScriptArgs
+	}
+	return nil
+}
+
+func (x *ExecScriptRequest) GetEnviron() []string {
+	if x != nil {
+		return x.Environ
+	}
+	return nil
+}
+
+func (x *ExecScriptRequest) GetTraceFile() string {
+	if x != nil && x.TraceFile != nil {
+		return *x.TraceFile
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ExecScriptRequest) GetRunAllCommands() bool {
+	if x != nil {
+		return x.RunAllCommands
+	}
+	return false
+}
+
+func (x *ExecScriptRequest) GetBuildVerbosity() ExecScriptRequest_BuildVerbosity {
+	if x != nil {
+		return x.BuildVerbosity
+	}
+	return ExecScriptRequest_BUILD_VERBOSITY_NORMAL
+}
+
+func (x *ExecScriptRequest) GetRunAsUser() string {
+	if x != nil && x.RunAsUser != nil {
+		return *x.RunAsUser
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetKeepBinary() bool {
+	if x != nil {
+		return x.KeepBinary
+	}
+	return false
+}
+
+func (x *ExecScriptRequest) GetContextValues() map[string]string {
+	if x != nil {
+		return x.ContextValues
+	}
+	return nil
+}
+
+func (x *ExecScriptRequest) GetSecretOverrides() map[string]string {
+	if x != nil {
+		return x.SecretOverrides
+	}
+	return nil
+}
+
+func (x *ExecScriptRequest) GetStandalone() bool {
+	if x != nil {
+		return x.Standalone
+	}
+	return false
+}
+
+func (x *ExecScriptRequest) GetCleanupCommandRelPath() string {
+	if x != nil && x.CleanupCommandRelPath != nil {
+		return *x.CleanupCommandRelPath
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetCleanupArgs() []string {
+	if x != nil {
+		return x.CleanupArgs
+	}
+	return nil
+}
+
+func (x *ExecScriptRequest) GetResumeId() string {
+	if x != nil && x.ResumeId != nil {
+		return *x.ResumeId
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetDetach() bool {
+	if x != nil {
+		return x.Detach
+	}
+	return false
+}
+
+func (x *ExecScriptRequest) GetRace() bool {
+	if x != nil {
+		return x.Race
+	}
+	return false
+}
+
+func (x *ExecScriptRequest) GetMaxOutputBytes() uint64 {
+	if x != nil {
+		return x.MaxOutputBytes
+	}
+	return 0
+}
+
+func (x *ExecScriptRequest) GetKillOnMaxOutput() bool {
+	if x != nil {
+		return x.KillOnMaxOutput
+	}
+	return false
+}
+
+func (x *ExecScriptRequest) GetTty() *TerminalSize {
+	if x != nil {
+		return x.Tty
+	}
+	return nil
+}
+
+func (x *ExecScriptRequest) GetBuildParallelism() uint32 {
+	if x != nil {
+		return x.BuildParallelism
+	}
+	return 0
+}
+
+func (x *ExecScriptRequest) GetEntrypoint() string {
+	if x != nil && x.Entrypoint != nil {
+		return *x.Entrypoint
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetTempDir() string {
+	if x != nil && x.TempDir != nil {
+		return *x.TempDir
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetScriptAppRoot() string {
+	if x != nil && x.ScriptAppRoot != nil {
+		return *x.ScriptAppRoot
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetMaxRetries() uint32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *ExecScriptRequest) GetBackoffSeconds() uint32 {
+	if x != nil {
+		return x.BackoffSeconds
+	}
+	return 0
+}
+
+func (x *ExecScriptRequest) GetShutdownGraceSeconds() uint32 {
+	if x != nil {
+		return x.ShutdownGraceSeconds
+	}
+	return 0
+}
+
+func (x *ExecScriptRequest) GetEphemeralNamespace() bool {
+	if x != nil {
+		return x.EphemeralNamespace
+	}
+	return false
+}
+
+func (x *ExecScriptRequest) GetMinLogLevel() string {
+	if x != nil && x.MinLogLevel != nil {
+		return *x.MinLogLevel
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetCommandName() string {
+	if x != nil && x.CommandName != nil {
+		return *x.CommandName
+	}
+	return ""
+}
+
+func (x *ExecScriptRequest) GetCollectArtifacts() bool {
+	if x != nil {
+		return x.CollectArtifacts
+	}
+	return false
+}
+
+func (x *ExecScriptRequest) GetLogResourceAccess() bool {
+	if x != nil {
+		return x.LogResourceAccess
+	}
+	return false
+}
+
+type ExecScriptResumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resume_id is the resume_id of the running (or recently finished) script.
+	ResumeId string `protobuf:"bytes,1,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
+	// since determines which buffered output to replay.
+	// If unset, all buffered output is replayed.
+	//
+	// Types that are assignable to Since:
+	//
+	//	*ExecScriptResumeRequest_SinceSeq
+	//	*ExecScriptResumeRequest_SinceTime
+	Since isExecScriptResumeRequest_Since `protobuf_oneof:"since"`
+}
+
+func (x *ExecScriptResumeRequest) Reset() {
+	*x = ExecScriptResumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encore_daemon_daemon_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecScriptResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecScriptResumeRequest) ProtoMessage() {}
+
+func (x *ExecScriptResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,66 +1577,83 @@ func (x *TestSpecRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestSpecRequest.ProtoReflect.Descriptor instead.
-func (*TestSpecRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ExecScriptResumeRequest.ProtoReflect.Descriptor instead.
+func (*ExecScriptResumeRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *TestSpecRequest) GetAppRoot() string {
+func (x *ExecScriptResumeRequest) GetResumeId() string {
 	if x != nil {
-		return x.AppRoot
+		return x.ResumeId
 	}
 	return ""
 }
 
-func (x *TestSpecRequest) GetWorkingDir() string {
-	if x != nil {
-		return x.WorkingDir
+func (m *ExecScriptResumeRequest) GetSince() isExecScriptResumeRequest_Since {
+	if m != nil {
+		return m.Since
 	}
-	return ""
+	return nil
 }
 
-func (x *TestSpecRequest) GetArgs() []string {
-	if x != nil {
-		return x.Args
+func (x *ExecScriptResumeRequest) GetSinceSeq() uint64 {
+	if x, ok := x.GetSince().(*ExecScriptResumeRequest_SinceSeq); ok {
+		return x.SinceSeq
 	}
-	return nil
+	return 0
 }
 
-func (x *TestSpecRequest) GetEnviron() []string {
-	if x != nil {
-		return x.Environ
+func (x *ExecScriptResumeRequest) GetSinceTime() *timestamppb.Timestamp {
+	if x, ok := x.GetSince().(*ExecScriptResumeRequest_SinceTime); ok {
+		return x.SinceTime
 	}
 	return nil
 }
 
-type TestSpecResponse struct {
+type isExecScriptResumeRequest_Since interface {
+	isExecScriptResumeRequest_Since()
+}
+
+type ExecScriptResumeRequest_SinceSeq struct {
+	// since_seq replays output with a sequence number of since_seq or later.
+	SinceSeq uint64 `protobuf:"varint,2,opt,name=since_seq,json=sinceSeq,proto3,oneof"`
+}
+
+type ExecScriptResumeRequest_SinceTime struct {
+	// since_time replays output produced at since_time or later.
+	SinceTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since_time,json=sinceTime,proto3,oneof"`
+}
+
+func (*ExecScriptResumeRequest_SinceSeq) isExecScriptResumeRequest_Since() {}
+
+func (*ExecScriptResumeRequest_SinceTime) isExecScriptResumeRequest_Since() {}
+
+type ExecScriptCancelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Command string   `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
-	Args    []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
-	Environ []string `protobuf:"bytes,3,rep,name=environ,proto3" json:"environ,omitempty"`
+	// resume_id is the resume_id of the running script.
+	ResumeId string `protobuf:"bytes,1,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
 }
 
-func (x *TestSpecResponse) Reset() {
-	*x = TestSpecResponse{}
+func (x *ExecScriptCancelRequest) Reset() {
+	*x = ExecScriptCancelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[7]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestSpecResponse) String() string {
+func (x *ExecScriptCancelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestSpecResponse) ProtoMessage() {}
+func (*ExecScriptCancelRequest) ProtoMessage() {}
 
-func (x *TestSpecResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[7]
+func (x *ExecScriptCancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,71 +1664,49 @@ func (x *TestSpecResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestSpecResponse.ProtoReflect.Descriptor instead.
-func (*TestSpecResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ExecScriptCancelRequest.ProtoReflect.Descriptor instead.
+func (*ExecScriptCancelRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *TestSpecResponse) GetCommand() string {
+func (x *ExecScriptCancelRequest) GetResumeId() string {
 	if x != nil {
-		return x.Command
+		return x.ResumeId
 	}
 	return ""
 }
 
-func (x *TestSpecResponse) GetArgs() []string {
-	if x != nil {
-		return x.Args
-	}
-	return nil
-}
-
-func (x *TestSpecResponse) GetEnviron() []string {
-	if x != nil {
-		return x.Environ
-	}
-	return nil
-}
-
-type ExecScriptRequest struct {
+// ExecScriptSessionRequest is a message sent by the client
+// in an interactive script session.
+type ExecScriptSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppRoot    string `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
-	WorkingDir string `protobuf:"bytes,2,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
-	// command_rel_path is the slash-separated relative path to the command to run,
-	// from the app root.
-	CommandRelPath string   `protobuf:"bytes,3,opt,name=command_rel_path,json=commandRelPath,proto3" json:"command_rel_path,omitempty"`
-	ScriptArgs     []string `protobuf:"bytes,4,rep,name=script_args,json=scriptArgs,proto3" json:"script_args,omitempty"`
-	// environ is the environment to set for the running command.
-	// Each entry is a string in the format "KEY=VALUE", identical to os.Environ().
-	Environ []string `protobuf:"bytes,5,rep,name=environ,proto3" json:"environ,omitempty"`
-	// trace_file, if set specifies a trace file to write trace information
-	// about the parse and compilation process to.
-	TraceFile *string `protobuf:"bytes,6,opt,name=trace_file,json=traceFile,proto3,oneof" json:"trace_file,omitempty"`
-	// namespace is the infrastructure namespace to use.
-	// If empty the active namespace is used.
-	Namespace *string `protobuf:"bytes,7,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	// Types that are assignable to Msg:
+	//
+	//	*ExecScriptSessionRequest_Start
+	//	*ExecScriptSessionRequest_Command
+	Msg isExecScriptSessionRequest_Msg `protobuf_oneof:"msg"`
 }
 
-func (x *ExecScriptRequest) Reset() {
-	*x = ExecScriptRequest{}
+func (x *ExecScriptSessionRequest) Reset() {
+	*x = ExecScriptSessionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[8]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecScriptRequest) String() string {
+func (x *ExecScriptSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecScriptRequest) ProtoMessage() {}
+func (*ExecScriptSessionRequest) ProtoMessage() {}
 
-func (x *ExecScriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[8]
+func (x *ExecScriptSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,56 +1717,103 @@ func (x *ExecScriptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecScriptRequest.ProtoReflect.Descriptor instead.
-func (*ExecScriptRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ExecScriptSessionRequest.ProtoReflect.Descriptor instead.
+func (*ExecScriptSessionRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ExecScriptRequest) GetAppRoot() string {
-	if x != nil {
-		return x.AppRoot
+func (m *ExecScriptSessionRequest) GetMsg() isExecScriptSessionRequest_Msg {
+	if m != nil {
+		return m.Msg
 	}
-	return ""
+	return nil
 }
 
-func (x *ExecScriptRequest) GetWorkingDir() string {
-	if x != nil {
-		return x.WorkingDir
+func (x *ExecScriptSessionRequest) GetStart() *ExecScriptRequest {
+	if x, ok := x.GetMsg().(*ExecScriptSessionRequest_Start); ok {
+		return x.Start
 	}
-	return ""
+	return nil
 }
 
-func (x *ExecScriptRequest) GetCommandRelPath() string {
-	if x != nil {
-		return x.CommandRelPath
+func (x *ExecScriptSessionRequest) GetCommand() *ExecScriptCommand {
+	if x, ok := x.GetMsg().(*ExecScriptSessionRequest_Command); ok {
+		return x.Command
 	}
-	return ""
+	return nil
 }
 
-func (x *ExecScriptRequest) GetScriptArgs() []string {
-	if x != nil {
-		return x.ScriptArgs
-	}
-	return nil
+type isExecScriptSessionRequest_Msg interface {
+	isExecScriptSessionRequest_Msg()
 }
 
-func (x *ExecScriptRequest) GetEnviron() []string {
-	if x != nil {
-		return x.Environ
+type ExecScriptSessionRequest_Start struct {
+	// start starts the script. It must be the first message in the session,
+	// and must refer to a single command (run_all_commands is not supported).
+	Start *ExecScriptRequest `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type ExecScriptSessionRequest_Command struct {
+	// command is a command to send to the running script.
+	Command *ExecScriptCommand `protobuf:"bytes,2,opt,name=command,proto3,oneof"`
+}
+
+func (*ExecScriptSessionRequest_Start) isExecScriptSessionRequest_Msg() {}
+
+func (*ExecScriptSessionRequest_Command) isExecScriptSessionRequest_Msg() {}
+
+// ExecScriptCommand is a command in an interactive script session.
+//
+// The protocol is line-based: each command is written to the script's
+// standard input as a single line, and the script writes its response to
+// standard output (or standard error) as usual. The script keeps running
+// between commands, so it only needs to be compiled and initialized once.
+// Closing the client side of the stream closes the script's standard input,
+// which the script should treat as the end of the session.
+type ExecScriptCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// line is the command to send. It must not contain newlines.
+	Line string `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+}
+
+func (x *ExecScriptCommand) Reset() {
+	*x = ExecScriptCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encore_daemon_daemon_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ExecScriptRequest) GetTraceFile() string {
-	if x != nil && x.TraceFile != nil {
-		return *x.TraceFile
+func (x *ExecScriptCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecScriptCommand) ProtoMessage() {}
+
+func (x *ExecScriptCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ExecScriptRequest) GetNamespace() string {
-	if x != nil && x.Namespace != nil {
-		return *x.Namespace
+// Deprecated: Use ExecScriptCommand.ProtoReflect.Descriptor instead.
+func (*ExecScriptCommand) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExecScriptCommand) GetLine() string {
+	if x != nil {
+		return x.Line
 	}
 	return ""
 }
@@ -889,7 +1837,7 @@ type CheckRequest struct {
 func (x *CheckRequest) Reset() {
 	*x = CheckRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[9]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -902,7 +1850,7 @@ func (x *CheckRequest) String() string {
 func (*CheckRequest) ProtoMessage() {}
 
 func (x *CheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[9]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -915,7 +1863,7 @@ func (x *CheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
 func (*CheckRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{9}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CheckRequest) GetAppRoot() string {
@@ -979,7 +1927,7 @@ type ExportRequest struct {
 func (x *ExportRequest) Reset() {
 	*x = ExportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[10]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -992,7 +1940,7 @@ func (x *ExportRequest) String() string {
 func (*ExportRequest) ProtoMessage() {}
 
 func (x *ExportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[10]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1005,7 +1953,7 @@ func (x *ExportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
 func (*ExportRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{10}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ExportRequest) GetAppRoot() string {
@@ -1088,7 +2036,7 @@ type DockerExportParams struct {
 func (x *DockerExportParams) Reset() {
 	*x = DockerExportParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[11]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1101,7 +2049,7 @@ func (x *DockerExportParams) String() string {
 func (*DockerExportParams) ProtoMessage() {}
 
 func (x *DockerExportParams) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[11]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1114,7 +2062,7 @@ func (x *DockerExportParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerExportParams.ProtoReflect.Descriptor instead.
 func (*DockerExportParams) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{11}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DockerExportParams) GetLocalDaemonTag() string {
@@ -1155,7 +2103,7 @@ type DBConnectRequest struct {
 func (x *DBConnectRequest) Reset() {
 	*x = DBConnectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[12]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1168,7 +2116,7 @@ func (x *DBConnectRequest) String() string {
 func (*DBConnectRequest) ProtoMessage() {}
 
 func (x *DBConnectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[12]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,7 +2129,7 @@ func (x *DBConnectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DBConnectRequest.ProtoReflect.Descriptor instead.
 func (*DBConnectRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{12}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DBConnectRequest) GetAppRoot() string {
@@ -1230,7 +2178,7 @@ type DBConnectResponse struct {
 func (x *DBConnectResponse) Reset() {
 	*x = DBConnectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[13]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1243,7 +2191,7 @@ func (x *DBConnectResponse) String() string {
 func (*DBConnectResponse) ProtoMessage() {}
 
 func (x *DBConnectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[13]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,7 +2204,7 @@ func (x *DBConnectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DBConnectResponse.ProtoReflect.Descriptor instead.
 func (*DBConnectResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{13}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DBConnectResponse) GetDsn() string {
@@ -1283,7 +2231,7 @@ type DBProxyRequest struct {
 func (x *DBProxyRequest) Reset() {
 	*x = DBProxyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[14]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1296,7 +2244,7 @@ func (x *DBProxyRequest) String() string {
 func (*DBProxyRequest) ProtoMessage() {}
 
 func (x *DBProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[14]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1309,7 +2257,7 @@ func (x *DBProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DBProxyRequest.ProtoReflect.Descriptor instead.
 func (*DBProxyRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{14}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DBProxyRequest) GetAppRoot() string {
@@ -1363,7 +2311,7 @@ type DBResetRequest struct {
 func (x *DBResetRequest) Reset() {
 	*x = DBResetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[15]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1376,7 +2324,7 @@ func (x *DBResetRequest) String() string {
 func (*DBResetRequest) ProtoMessage() {}
 
 func (x *DBResetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[15]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1389,7 +2337,7 @@ func (x *DBResetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DBResetRequest.ProtoReflect.Descriptor instead.
 func (*DBResetRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{15}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DBResetRequest) GetAppRoot() string {
@@ -1440,7 +2388,7 @@ type GenClientRequest struct {
 func (x *GenClientRequest) Reset() {
 	*x = GenClientRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[16]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1453,7 +2401,7 @@ func (x *GenClientRequest) String() string {
 func (*GenClientRequest) ProtoMessage() {}
 
 func (x *GenClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[16]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1466,7 +2414,7 @@ func (x *GenClientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenClientRequest.ProtoReflect.Descriptor instead.
 func (*GenClientRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{16}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GenClientRequest) GetAppId() string {
@@ -1522,7 +2470,7 @@ type GenClientResponse struct {
 func (x *GenClientResponse) Reset() {
 	*x = GenClientResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[17]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1535,7 +2483,7 @@ func (x *GenClientResponse) String() string {
 func (*GenClientResponse) ProtoMessage() {}
 
 func (x *GenClientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[17]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1548,7 +2496,7 @@ func (x *GenClientResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenClientResponse.ProtoReflect.Descriptor instead.
 func (*GenClientResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{17}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GenClientResponse) GetCode() []byte {
@@ -1569,7 +2517,7 @@ type GenWrappersRequest struct {
 func (x *GenWrappersRequest) Reset() {
 	*x = GenWrappersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[18]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1582,7 +2530,7 @@ func (x *GenWrappersRequest) String() string {
 func (*GenWrappersRequest) ProtoMessage() {}
 
 func (x *GenWrappersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[18]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1595,7 +2543,7 @@ func (x *GenWrappersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenWrappersRequest.ProtoReflect.Descriptor instead.
 func (*GenWrappersRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{18}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GenWrappersRequest) GetAppRoot() string {
@@ -1614,7 +2562,7 @@ type GenWrappersResponse struct {
 func (x *GenWrappersResponse) Reset() {
 	*x = GenWrappersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[19]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1627,7 +2575,7 @@ func (x *GenWrappersResponse) String() string {
 func (*GenWrappersResponse) ProtoMessage() {}
 
 func (x *GenWrappersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[19]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1640,7 +2588,7 @@ func (x *GenWrappersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenWrappersResponse.ProtoReflect.Descriptor instead.
 func (*GenWrappersResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{19}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{26}
 }
 
 type SecretsRefreshRequest struct {
@@ -1656,7 +2604,7 @@ type SecretsRefreshRequest struct {
 func (x *SecretsRefreshRequest) Reset() {
 	*x = SecretsRefreshRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[20]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1669,7 +2617,7 @@ func (x *SecretsRefreshRequest) String() string {
 func (*SecretsRefreshRequest) ProtoMessage() {}
 
 func (x *SecretsRefreshRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[20]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1682,7 +2630,7 @@ func (x *SecretsRefreshRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretsRefreshRequest.ProtoReflect.Descriptor instead.
 func (*SecretsRefreshRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{20}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *SecretsRefreshRequest) GetAppRoot() string {
@@ -1715,7 +2663,7 @@ type SecretsRefreshResponse struct {
 func (x *SecretsRefreshResponse) Reset() {
 	*x = SecretsRefreshResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[21]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1728,7 +2676,7 @@ func (x *SecretsRefreshResponse) String() string {
 func (*SecretsRefreshResponse) ProtoMessage() {}
 
 func (x *SecretsRefreshResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[21]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1741,7 +2689,7 @@ func (x *SecretsRefreshResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretsRefreshResponse.ProtoReflect.Descriptor instead.
 func (*SecretsRefreshResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{21}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{28}
 }
 
 type VersionResponse struct {
@@ -1756,7 +2704,7 @@ type VersionResponse struct {
 func (x *VersionResponse) Reset() {
 	*x = VersionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[22]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1769,7 +2717,7 @@ func (x *VersionResponse) String() string {
 func (*VersionResponse) ProtoMessage() {}
 
 func (x *VersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[22]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1782,7 +2730,7 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{22}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *VersionResponse) GetVersion() string {
@@ -1814,7 +2762,7 @@ type Namespace struct {
 func (x *Namespace) Reset() {
 	*x = Namespace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[23]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1827,7 +2775,7 @@ func (x *Namespace) String() string {
 func (*Namespace) ProtoMessage() {}
 
 func (x *Namespace) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[23]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,7 +2788,7 @@ func (x *Namespace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Namespace.ProtoReflect.Descriptor instead.
 func (*Namespace) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{23}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *Namespace) GetId() string {
@@ -1890,7 +2838,7 @@ type CreateNamespaceRequest struct {
 func (x *CreateNamespaceRequest) Reset() {
 	*x = CreateNamespaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[24]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1903,7 +2851,7 @@ func (x *CreateNamespaceRequest) String() string {
 func (*CreateNamespaceRequest) ProtoMessage() {}
 
 func (x *CreateNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[24]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1916,7 +2864,7 @@ func (x *CreateNamespaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNamespaceRequest.ProtoReflect.Descriptor instead.
 func (*CreateNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{24}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *CreateNamespaceRequest) GetAppRoot() string {
@@ -1946,7 +2894,7 @@ type SwitchNamespaceRequest struct {
 func (x *SwitchNamespaceRequest) Reset() {
 	*x = SwitchNamespaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[25]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1959,7 +2907,7 @@ func (x *SwitchNamespaceRequest) String() string {
 func (*SwitchNamespaceRequest) ProtoMessage() {}
 
 func (x *SwitchNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[25]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1972,7 +2920,7 @@ func (x *SwitchNamespaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwitchNamespaceRequest.ProtoReflect.Descriptor instead.
 func (*SwitchNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{25}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SwitchNamespaceRequest) GetAppRoot() string {
@@ -2007,7 +2955,7 @@ type ListNamespacesRequest struct {
 func (x *ListNamespacesRequest) Reset() {
 	*x = ListNamespacesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[26]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2020,7 +2968,7 @@ func (x *ListNamespacesRequest) String() string {
 func (*ListNamespacesRequest) ProtoMessage() {}
 
 func (x *ListNamespacesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[26]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2033,7 +2981,7 @@ func (x *ListNamespacesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNamespacesRequest.ProtoReflect.Descriptor instead.
 func (*ListNamespacesRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{26}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ListNamespacesRequest) GetAppRoot() string {
@@ -2055,7 +3003,7 @@ type DeleteNamespaceRequest struct {
 func (x *DeleteNamespaceRequest) Reset() {
 	*x = DeleteNamespaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[27]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2068,7 +3016,7 @@ func (x *DeleteNamespaceRequest) String() string {
 func (*DeleteNamespaceRequest) ProtoMessage() {}
 
 func (x *DeleteNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[27]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2081,7 +3029,7 @@ func (x *DeleteNamespaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNamespaceRequest.ProtoReflect.Descriptor instead.
 func (*DeleteNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{27}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DeleteNamespaceRequest) GetAppRoot() string {
@@ -2109,7 +3057,7 @@ type ListNamespacesResponse struct {
 func (x *ListNamespacesResponse) Reset() {
 	*x = ListNamespacesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[28]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2122,7 +3070,7 @@ func (x *ListNamespacesResponse) String() string {
 func (*ListNamespacesResponse) ProtoMessage() {}
 
 func (x *ListNamespacesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[28]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2135,7 +3083,7 @@ func (x *ListNamespacesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNamespacesResponse.ProtoReflect.Descriptor instead.
 func (*ListNamespacesResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{28}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListNamespacesResponse) GetNamespaces() []*Namespace {
@@ -2158,7 +3106,7 @@ type TelemetryConfig struct {
 func (x *TelemetryConfig) Reset() {
 	*x = TelemetryConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[29]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2171,7 +3119,7 @@ func (x *TelemetryConfig) String() string {
 func (*TelemetryConfig) ProtoMessage() {}
 
 func (x *TelemetryConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[29]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2184,7 +3132,7 @@ func (x *TelemetryConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TelemetryConfig.ProtoReflect.Descriptor instead.
 func (*TelemetryConfig) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{29}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *TelemetryConfig) GetAnonId() string {
@@ -2226,7 +3174,7 @@ type DumpMetaRequest struct {
 func (x *DumpMetaRequest) Reset() {
 	*x = DumpMetaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[30]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2239,7 +3187,7 @@ func (x *DumpMetaRequest) String() string {
 func (*DumpMetaRequest) ProtoMessage() {}
 
 func (x *DumpMetaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[30]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2252,7 +3200,7 @@ func (x *DumpMetaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DumpMetaRequest.ProtoReflect.Descriptor instead.
 func (*DumpMetaRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{30}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *DumpMetaRequest) GetAppRoot() string {
@@ -2301,7 +3249,7 @@ type DumpMetaResponse struct {
 func (x *DumpMetaResponse) Reset() {
 	*x = DumpMetaResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[31]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2314,7 +3262,7 @@ func (x *DumpMetaResponse) String() string {
 func (*DumpMetaResponse) ProtoMessage() {}
 
 func (x *DumpMetaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[31]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2327,7 +3275,7 @@ func (x *DumpMetaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DumpMetaResponse.ProtoReflect.Descriptor instead.
 func (*DumpMetaResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{31}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *DumpMetaResponse) GetMeta() []byte {
@@ -2347,7 +3295,7 @@ type SQLCPlugin struct {
 func (x *SQLCPlugin) Reset() {
 	*x = SQLCPlugin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[32]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2360,7 +3308,7 @@ func (x *SQLCPlugin) String() string {
 func (*SQLCPlugin) ProtoMessage() {}
 
 func (x *SQLCPlugin) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[32]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2373,7 +3321,7 @@ func (x *SQLCPlugin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39}
 }
 
 type SQLCPlugin_File struct {
@@ -2388,7 +3336,7 @@ type SQLCPlugin_File struct {
 func (x *SQLCPlugin_File) Reset() {
 	*x = SQLCPlugin_File{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[33]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2401,7 +3349,7 @@ func (x *SQLCPlugin_File) String() string {
 func (*SQLCPlugin_File) ProtoMessage() {}
 
 func (x *SQLCPlugin_File) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[33]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2414,7 +3362,7 @@ func (x *SQLCPlugin_File) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_File.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_File) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 0}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 0}
 }
 
 func (x *SQLCPlugin_File) GetName() string {
@@ -2446,7 +3394,7 @@ type SQLCPlugin_Settings struct {
 func (x *SQLCPlugin_Settings) Reset() {
 	*x = SQLCPlugin_Settings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[34]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2459,7 +3407,7 @@ func (x *SQLCPlugin_Settings) String() string {
 func (*SQLCPlugin_Settings) ProtoMessage() {}
 
 func (x *SQLCPlugin_Settings) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[34]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2472,7 +3420,7 @@ func (x *SQLCPlugin_Settings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Settings.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Settings) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 1}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 1}
 }
 
 func (x *SQLCPlugin_Settings) GetVersion() string {
@@ -2526,7 +3474,7 @@ type SQLCPlugin_Codegen struct {
 func (x *SQLCPlugin_Codegen) Reset() {
 	*x = SQLCPlugin_Codegen{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[35]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2539,7 +3487,7 @@ func (x *SQLCPlugin_Codegen) String() string {
 func (*SQLCPlugin_Codegen) ProtoMessage() {}
 
 func (x *SQLCPlugin_Codegen) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[35]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2552,7 +3500,7 @@ func (x *SQLCPlugin_Codegen) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Codegen.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Codegen) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 2}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 2}
 }
 
 func (x *SQLCPlugin_Codegen) GetOut() string {
@@ -2611,7 +3559,7 @@ type SQLCPlugin_Catalog struct {
 func (x *SQLCPlugin_Catalog) Reset() {
 	*x = SQLCPlugin_Catalog{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[36]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2624,7 +3572,7 @@ func (x *SQLCPlugin_Catalog) String() string {
 func (*SQLCPlugin_Catalog) ProtoMessage() {}
 
 func (x *SQLCPlugin_Catalog) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[36]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2637,7 +3585,7 @@ func (x *SQLCPlugin_Catalog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Catalog.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Catalog) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 3}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 3}
 }
 
 func (x *SQLCPlugin_Catalog) GetComment() string {
@@ -2683,7 +3631,7 @@ type SQLCPlugin_Schema struct {
 func (x *SQLCPlugin_Schema) Reset() {
 	*x = SQLCPlugin_Schema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[37]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2696,7 +3644,7 @@ func (x *SQLCPlugin_Schema) String() string {
 func (*SQLCPlugin_Schema) ProtoMessage() {}
 
 func (x *SQLCPlugin_Schema) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[37]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2709,7 +3657,7 @@ func (x *SQLCPlugin_Schema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Schema.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Schema) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 4}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 4}
 }
 
 func (x *SQLCPlugin_Schema) GetComment() string {
@@ -2759,7 +3707,7 @@ type SQLCPlugin_CompositeType struct {
 func (x *SQLCPlugin_CompositeType) Reset() {
 	*x = SQLCPlugin_CompositeType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[38]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2772,7 +3720,7 @@ func (x *SQLCPlugin_CompositeType) String() string {
 func (*SQLCPlugin_CompositeType) ProtoMessage() {}
 
 func (x *SQLCPlugin_CompositeType) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[38]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2785,7 +3733,7 @@ func (x *SQLCPlugin_CompositeType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_CompositeType.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_CompositeType) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 5}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 5}
 }
 
 func (x *SQLCPlugin_CompositeType) GetName() string {
@@ -2815,7 +3763,7 @@ type SQLCPlugin_Enum struct {
 func (x *SQLCPlugin_Enum) Reset() {
 	*x = SQLCPlugin_Enum{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[39]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2828,7 +3776,7 @@ func (x *SQLCPlugin_Enum) String() string {
 func (*SQLCPlugin_Enum) ProtoMessage() {}
 
 func (x *SQLCPlugin_Enum) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[39]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2841,7 +3789,7 @@ func (x *SQLCPlugin_Enum) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Enum.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Enum) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 6}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 6}
 }
 
 func (x *SQLCPlugin_Enum) GetName() string {
@@ -2878,7 +3826,7 @@ type SQLCPlugin_Table struct {
 func (x *SQLCPlugin_Table) Reset() {
 	*x = SQLCPlugin_Table{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[40]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2891,7 +3839,7 @@ func (x *SQLCPlugin_Table) String() string {
 func (*SQLCPlugin_Table) ProtoMessage() {}
 
 func (x *SQLCPlugin_Table) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[40]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2904,7 +3852,7 @@ func (x *SQLCPlugin_Table) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Table.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Table) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 7}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 7}
 }
 
 func (x *SQLCPlugin_Table) GetRel() *SQLCPlugin_Identifier {
@@ -2941,7 +3889,7 @@ type SQLCPlugin_Identifier struct {
 func (x *SQLCPlugin_Identifier) Reset() {
 	*x = SQLCPlugin_Identifier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[41]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2954,7 +3902,7 @@ func (x *SQLCPlugin_Identifier) String() string {
 func (*SQLCPlugin_Identifier) ProtoMessage() {}
 
 func (x *SQLCPlugin_Identifier) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[41]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2967,7 +3915,7 @@ func (x *SQLCPlugin_Identifier) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Identifier.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Identifier) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 8}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 8}
 }
 
 func (x *SQLCPlugin_Identifier) GetCatalog() string {
@@ -3018,7 +3966,7 @@ type SQLCPlugin_Column struct {
 func (x *SQLCPlugin_Column) Reset() {
 	*x = SQLCPlugin_Column{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[42]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3031,7 +3979,7 @@ func (x *SQLCPlugin_Column) String() string {
 func (*SQLCPlugin_Column) ProtoMessage() {}
 
 func (x *SQLCPlugin_Column) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[42]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3044,7 +3992,7 @@ func (x *SQLCPlugin_Column) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Column.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Column) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 9}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 9}
 }
 
 func (x *SQLCPlugin_Column) GetName() string {
@@ -3177,7 +4125,7 @@ type SQLCPlugin_Query struct {
 func (x *SQLCPlugin_Query) Reset() {
 	*x = SQLCPlugin_Query{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[43]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3190,7 +4138,7 @@ func (x *SQLCPlugin_Query) String() string {
 func (*SQLCPlugin_Query) ProtoMessage() {}
 
 func (x *SQLCPlugin_Query) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[43]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3203,7 +4151,7 @@ func (x *SQLCPlugin_Query) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Query.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Query) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 10}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 10}
 }
 
 func (x *SQLCPlugin_Query) GetText() string {
@@ -3274,7 +4222,7 @@ type SQLCPlugin_Parameter struct {
 func (x *SQLCPlugin_Parameter) Reset() {
 	*x = SQLCPlugin_Parameter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[44]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3287,7 +4235,7 @@ func (x *SQLCPlugin_Parameter) String() string {
 func (*SQLCPlugin_Parameter) ProtoMessage() {}
 
 func (x *SQLCPlugin_Parameter) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[44]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3300,7 +4248,7 @@ func (x *SQLCPlugin_Parameter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Parameter.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Parameter) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 11}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 11}
 }
 
 func (x *SQLCPlugin_Parameter) GetNumber() int32 {
@@ -3333,7 +4281,7 @@ type SQLCPlugin_GenerateRequest struct {
 func (x *SQLCPlugin_GenerateRequest) Reset() {
 	*x = SQLCPlugin_GenerateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[45]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3346,7 +4294,7 @@ func (x *SQLCPlugin_GenerateRequest) String() string {
 func (*SQLCPlugin_GenerateRequest) ProtoMessage() {}
 
 func (x *SQLCPlugin_GenerateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[45]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3359,7 +4307,7 @@ func (x *SQLCPlugin_GenerateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_GenerateRequest.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_GenerateRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 12}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 12}
 }
 
 func (x *SQLCPlugin_GenerateRequest) GetSettings() *SQLCPlugin_Settings {
@@ -3415,7 +4363,7 @@ type SQLCPlugin_GenerateResponse struct {
 func (x *SQLCPlugin_GenerateResponse) Reset() {
 	*x = SQLCPlugin_GenerateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[46]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3428,7 +4376,7 @@ func (x *SQLCPlugin_GenerateResponse) String() string {
 func (*SQLCPlugin_GenerateResponse) ProtoMessage() {}
 
 func (x *SQLCPlugin_GenerateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[46]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3441,7 +4389,7 @@ func (x *SQLCPlugin_GenerateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_GenerateResponse.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_GenerateResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 13}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 13}
 }
 
 func (x *SQLCPlugin_GenerateResponse) GetFiles() []*SQLCPlugin_File {
@@ -3462,7 +4410,7 @@ type SQLCPlugin_Codegen_Process struct {
 func (x *SQLCPlugin_Codegen_Process) Reset() {
 	*x = SQLCPlugin_Codegen_Process{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[47]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3475,7 +4423,7 @@ func (x *SQLCPlugin_Codegen_Process) String() string {
 func (*SQLCPlugin_Codegen_Process) ProtoMessage() {}
 
 func (x *SQLCPlugin_Codegen_Process) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[47]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3488,7 +4436,7 @@ func (x *SQLCPlugin_Codegen_Process) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Codegen_Process.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Codegen_Process) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 2, 0}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 2, 0}
 }
 
 func (x *SQLCPlugin_Codegen_Process) GetCmd() string {
@@ -3510,7 +4458,7 @@ type SQLCPlugin_Codegen_WASM struct {
 func (x *SQLCPlugin_Codegen_WASM) Reset() {
 	*x = SQLCPlugin_Codegen_WASM{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_encore_daemon_daemon_proto_msgTypes[48]
+		mi := &file_encore_daemon_daemon_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3523,7 +4471,7 @@ func (x *SQLCPlugin_Codegen_WASM) String() string {
 func (*SQLCPlugin_Codegen_WASM) ProtoMessage() {}
 
 func (x *SQLCPlugin_Codegen_WASM) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[48]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3536,7 +4484,7 @@ func (x *SQLCPlugin_Codegen_WASM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Codegen_WASM.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Codegen_WASM) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 2, 1}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39, 2, 1}
 }
 
 func (x *SQLCPlugin_Codegen_WASM) GetUrl() string {
@@ -3558,101 +4506,282 @@ var File_encore_daemon_daemon_proto protoreflect.FileDescriptor
 var file_encore_daemon_daemon_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f,
 	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x65, 0x6e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc0, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x65, 0x78, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45, 0x78, 0x69, 0x74, 0x48, 0x00, 0x52,
-	0x04, 0x65, 0x78, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x44, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x48, 0x00, 0x52, 0x06, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x3f, 0x0a, 0x0d, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x74,
-	0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x22, 0x21, 0x0a, 0x0b,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45, 0x78, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
-	0x32, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x69, 0x6e,
-	0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x65, 0x72, 0x72, 0x69, 0x6e,
-	0x73, 0x72, 0x63, 0x22, 0x9c, 0x03, 0x0a, 0x0a, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64,
-	0x65, 0x62, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x77, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfe, 0x01, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65,
+	0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x65, 0x78, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45, 0x78, 0x69, 0x74, 0x48, 0x00,
+	0x52, 0x04, 0x65, 0x78, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x44, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x48, 0x00, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x48, 0x00, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x51, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x74, 0x64,
+	0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0x78, 0x0a,
+	0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45, 0x78, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x45, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x78, 0x65, 0x63,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x10, 0x45, 0x78, 0x65, 0x63,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x40, 0x0a, 0x0e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34,
+	0x0a, 0x08, 0x63, 0x70, 0x75, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x63, 0x70, 0x75,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x11, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x00, 0x52, 0x0f, 0x70, 0x65, 0x61, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6f, 0x66, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x03, 0x65, 0x6f, 0x66, 0x22, 0x32, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x69, 0x6e, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x65, 0x72, 0x72, 0x69, 0x6e, 0x73, 0x72, 0x63, 0x22, 0x9c, 0x03, 0x0a,
+	0x0a, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x77, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x07, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x62,
+	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x22, 0x46, 0x0a, 0x0b, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65,
+	0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x52, 0x4f, 0x57, 0x53, 0x45, 0x52,
+	0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x52, 0x4f, 0x57, 0x53,
+	0x45, 0x52, 0x5f, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x52,
+	0x4f, 0x57, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x02, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xd5, 0x01, 0x0a, 0x0b,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e,
 	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x66,
-	0x69, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x61,
-	0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x07,
-	0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
-	0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x75,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
-	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x22, 0x46, 0x0a,
-	0x0b, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x0c,
-	0x42, 0x52, 0x4f, 0x57, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x11,
-	0x0a, 0x0d, 0x42, 0x52, 0x4f, 0x57, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x10,
-	0x01, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x52, 0x4f, 0x57, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x4c, 0x57,
-	0x41, 0x59, 0x53, 0x10, 0x02, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f,
-	0x66, 0x69, 0x6c, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x22, 0xd5, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
-	0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0a,
-	0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x64, 0x65, 0x67, 0x65, 0x6e, 0x5f, 0x64, 0x65, 0x62, 0x75,
-	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x67, 0x65, 0x6e,
-	0x44, 0x65, 0x62, 0x75, 0x67, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f,
-	0x66, 0x69, 0x6c, 0x65, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x7b, 0x0a, 0x0f, 0x54, 0x65,
-	0x73, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
-	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77,
-	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x10, 0x54, 0x65, 0x73, 0x74, 0x53,
-	0x70, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x22, 0x98, 0x02, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70,
-	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70,
-	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
-	0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69,
-	0x6e, 0x67, 0x44, 0x69, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x5f, 0x72, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x72, 0x67, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x72,
-	0x61, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x09, 0x74, 0x72, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21,
-	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01,
-	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xaa,
+	0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x64,
+	0x65, 0x67, 0x65, 0x6e, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x67, 0x65, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x4a, 0x04, 0x08,
+	0x05, 0x10, 0x06, 0x22, 0x7b, 0x0a, 0x0f, 0x54, 0x65, 0x73, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44,
+	0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x22, 0x5a, 0x0a, 0x10, 0x54, 0x65, 0x73, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x0c,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x63, 0x6f, 0x6c, 0x73, 0x22, 0x9c, 0x10, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70,
+	0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x5f, 0x72, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x09, 0x74, 0x72, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x72,
+	0x75, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x58, 0x0a, 0x0f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f,
+	0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x52,
+	0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x12,
+	0x23, 0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x41, 0x73, 0x55, 0x73, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x62, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x42,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x5a, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x60, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x65, 0x6e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c,
+	0x6f, 0x6e, 0x65, 0x12, 0x3c, 0x0a, 0x18, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x15, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01,
+	0x01, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x5f, 0x61, 0x72, 0x67,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x41, 0x72, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x74, 0x61, 0x63, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61,
+	0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x72, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x2b, 0x0a, 0x12, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6b, 0x69,
+	0x6c, 0x6c, 0x4f, 0x6e, 0x4d, 0x61, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a,
+	0x03, 0x74, 0x74, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x48, 0x06, 0x52, 0x03, 0x74, 0x74, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x2b, 0x0a, 0x11, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c,
+	0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x23,
+	0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x07, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x64, 0x69, 0x72, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x07, 0x74, 0x65, 0x6d, 0x70, 0x44, 0x69, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x0a, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0b, 0x52, 0x0d, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x41, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66,
+	0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x68, 0x75, 0x74,
+	0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f,
+	0x77, 0x6e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2f,
+	0x0a, 0x13, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x70, 0x68,
+	0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x27, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0c, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0d,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x26, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6c, 0x6f, 0x67, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x1a, 0x40, 0x0a,
+	0x12, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x42, 0x0a, 0x14, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x64, 0x0a, 0x0e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x62,
+	0x6f, 0x73, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x56,
+	0x45, 0x52, 0x42, 0x4f, 0x53, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x4f,
+	0x53, 0x49, 0x54, 0x59, 0x5f, 0x51, 0x55, 0x49, 0x45, 0x54, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17,
+	0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x4f, 0x53, 0x49, 0x54, 0x59, 0x5f,
+	0x56, 0x45, 0x52, 0x42, 0x4f, 0x53, 0x45, 0x10, 0x02, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61,
+	0x73, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e,
+	0x75, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x6c, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x74, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x5f, 0x64, 0x69, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x09,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x00, 0x52, 0x08, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x71, 0x12, 0x3b, 0x0a, 0x0a, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x22, 0x36, 0x0a, 0x17, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x18, 0x45, 0x78,
+	0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x42, 0x05,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x27, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xaa,
 	0x01, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f,
@@ -3999,7 +5128,7 @@ var file_encore_daemon_daemon_proto_rawDesc = []byte{
 	0x52, 0x55, 0x4e, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x42, 0x5f, 0x43, 0x4c, 0x55, 0x53,
 	0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12,
 	0x1a, 0x0a, 0x16, 0x44, 0x42, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x44, 0x4f, 0x57, 0x10, 0x03, 0x32, 0xd7, 0x0b, 0x0a, 0x06,
+	0x50, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x44, 0x4f, 0x57, 0x10, 0x03, 0x32, 0xe9, 0x0d, 0x0a, 0x06,
 	0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x19, 0x2e,
 	0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x75,
 	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72,
@@ -4018,84 +5147,101 @@ var file_encore_daemon_daemon_proto_rawDesc = []byte{
 	0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63,
 	0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e,
 	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x05,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x2e,
-	0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x09,
-	0x44, 0x42, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x65, 0x6e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x42, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x6e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x42, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x07,
-	0x44, 0x42, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x42, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x07, 0x44, 0x42, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x44, 0x42, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x30, 0x01, 0x12, 0x4e, 0x0a, 0x09, 0x47, 0x65, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
-	0x1f, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x47, 0x65, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x47, 0x65, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
-	0x73, 0x12, 0x21, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x24, 0x2e, 0x65, 0x6e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x65, 0x6e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x25, 0x2e,
-	0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x52,
-	0x0a, 0x0f, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x12, 0x25, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x6e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x50, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x08, 0x44, 0x75, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x12,
-	0x1e, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x44, 0x75, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x44, 0x75, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x43, 0x0a, 0x09, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1e, 0x2e,
-	0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x65,
-	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x16, 0x2e,
+	0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x5f, 0x0a, 0x11,
+	0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x27, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5b, 0x0a,
+	0x10, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x12, 0x26, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x10, 0x45, 0x78,
+	0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x26,
+	0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45,
+	0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1c, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x4e,
+	0x0a, 0x09, 0x44, 0x42, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x65, 0x6e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x42, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65,
+	0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x42, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x07, 0x44, 0x42, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x42, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x07, 0x44, 0x42, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x42, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x09, 0x47, 0x65, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x1f, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x73, 0x12, 0x21, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x24, 0x2e, 0x65,
+	0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x65,
+	0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x25, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x52, 0x0a, 0x0f, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65,
+	0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x1e, 0x5a, 0x1c, 0x65, 0x6e, 0x63, 0x72, 0x2e, 0x64, 0x65,
-	0x76, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x64,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x08, 0x44, 0x75, 0x6d, 0x70, 0x4d, 0x65, 0x74,
+	0x61, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12,
+	0x1e, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x1e, 0x5a, 0x1c, 0x65, 0x6e, 0x63, 0x72, 0x2e,
+	0x64, 0x65, 0x76, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4110,137 +5256,166 @@ func file_encore_daemon_daemon_proto_rawDescGZIP() []byte {
 	return file_encore_daemon_daemon_proto_rawDescData
 }
 
-var file_encore_daemon_daemon_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_encore_daemon_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_encore_daemon_daemon_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_encore_daemon_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_encore_daemon_daemon_proto_goTypes = []interface{}{
-	(DBClusterType)(0),                  // 0: encore.daemon.DBClusterType
-	(RunRequest_BrowserMode)(0),         // 1: encore.daemon.RunRequest.BrowserMode
-	(DumpMetaRequest_Format)(0),         // 2: encore.daemon.DumpMetaRequest.Format
-	(*CommandMessage)(nil),              // 3: encore.daemon.CommandMessage
-	(*CommandOutput)(nil),               // 4: encore.daemon.CommandOutput
-	(*CommandExit)(nil),                 // 5: encore.daemon.CommandExit
-	(*CommandDisplayErrors)(nil),        // 6: encore.daemon.CommandDisplayErrors
-	(*RunRequest)(nil),                  // 7: encore.daemon.RunRequest
-	(*TestRequest)(nil),                 // 8: encore.daemon.TestRequest
-	(*TestSpecRequest)(nil),             // 9: encore.daemon.TestSpecRequest
-	(*TestSpecResponse)(nil),            // 10: encore.daemon.TestSpecResponse
-	(*ExecScriptRequest)(nil),           // 11: encore.daemon.ExecScriptRequest
-	(*CheckRequest)(nil),                // 12: encore.daemon.CheckRequest
-	(*ExportRequest)(nil),               // 13: encore.daemon.ExportRequest
-	(*DockerExportParams)(nil),          // 14: encore.daemon.DockerExportParams
-	(*DBConnectRequest)(nil),            // 15: encore.daemon.DBConnectRequest
-	(*DBConnectResponse)(nil),           // 16: encore.daemon.DBConnectResponse
-	(*DBProxyRequest)(nil),              // 17: encore.daemon.DBProxyRequest
-	(*DBResetRequest)(nil),              // 18: encore.daemon.DBResetRequest
-	(*GenClientRequest)(nil),            // 19: encore.daemon.GenClientRequest
-	(*GenClientResponse)(nil),           // 20: encore.daemon.GenClientResponse
-	(*GenWrappersRequest)(nil),          // 21: encore.daemon.GenWrappersRequest
-	(*GenWrappersResponse)(nil),         // 22: encore.daemon.GenWrappersResponse
-	(*SecretsRefreshRequest)(nil),       // 23: encore.daemon.SecretsRefreshRequest
-	(*SecretsRefreshResponse)(nil),      // 24: encore.daemon.SecretsRefreshResponse
-	(*VersionResponse)(nil),             // 25: encore.daemon.VersionResponse
-	(*Namespace)(nil),                   // 26: encore.daemon.Namespace
-	(*CreateNamespaceRequest)(nil),      // 27: encore.daemon.CreateNamespaceRequest
-	(*SwitchNamespaceRequest)(nil),      // 28: encore.daemon.SwitchNamespaceRequest
-	(*ListNamespacesRequest)(nil),       // 29: encore.daemon.ListNamespacesRequest
-	(*DeleteNamespaceRequest)(nil),      // 30: encore.daemon.DeleteNamespaceRequest
-	(*ListNamespacesResponse)(nil),      // 31: encore.daemon.ListNamespacesResponse
-	(*TelemetryConfig)(nil),             // 32: encore.daemon.TelemetryConfig
-	(*DumpMetaRequest)(nil),             // 33: encore.daemon.DumpMetaRequest
-	(*DumpMetaResponse)(nil),            // 34: encore.daemon.DumpMetaResponse
-	(*SQLCPlugin)(nil),                  // 35: encore.daemon.SQLCPlugin
-	(*SQLCPlugin_File)(nil),             // 36: encore.daemon.SQLCPlugin.File
-	(*SQLCPlugin_Settings)(nil),         // 37: encore.daemon.SQLCPlugin.Settings
-	(*SQLCPlugin_Codegen)(nil),          // 38: encore.daemon.SQLCPlugin.Codegen
-	(*SQLCPlugin_Catalog)(nil),          // 39: encore.daemon.SQLCPlugin.Catalog
-	(*SQLCPlugin_Schema)(nil),           // 40: encore.daemon.SQLCPlugin.Schema
-	(*SQLCPlugin_CompositeType)(nil),    // 41: encore.daemon.SQLCPlugin.CompositeType
-	(*SQLCPlugin_Enum)(nil),             // 42: encore.daemon.SQLCPlugin.Enum
-	(*SQLCPlugin_Table)(nil),            // 43: encore.daemon.SQLCPlugin.Table
-	(*SQLCPlugin_Identifier)(nil),       // 44: encore.daemon.SQLCPlugin.Identifier
-	(*SQLCPlugin_Column)(nil),           // 45: encore.daemon.SQLCPlugin.Column
-	(*SQLCPlugin_Query)(nil),            // 46: encore.daemon.SQLCPlugin.Query
-	(*SQLCPlugin_Parameter)(nil),        // 47: encore.daemon.SQLCPlugin.Parameter
-	(*SQLCPlugin_GenerateRequest)(nil),  // 48: encore.daemon.SQLCPlugin.GenerateRequest
-	(*SQLCPlugin_GenerateResponse)(nil), // 49: encore.daemon.SQLCPlugin.GenerateResponse
-	(*SQLCPlugin_Codegen_Process)(nil),  // 50: encore.daemon.SQLCPlugin.Codegen.Process
-	(*SQLCPlugin_Codegen_WASM)(nil),     // 51: encore.daemon.SQLCPlugin.Codegen.WASM
-	(*emptypb.Empty)(nil),               // 52: google.protobuf.Empty
+	(DBClusterType)(0),                    // 0: encore.daemon.DBClusterType
+	(RunRequest_BrowserMode)(0),           // 1: encore.daemon.RunRequest.BrowserMode
+	(ExecScriptRequest_BuildVerbosity)(0), // 2: encore.daemon.ExecScriptRequest.BuildVerbosity
+	(DumpMetaRequest_Format)(0),           // 3: encore.daemon.DumpMetaRequest.Format
+	(*CommandMessage)(nil),                // 4: encore.daemon.CommandMessage
+	(*CommandOutput)(nil),                 // 5: encore.daemon.CommandOutput
+	(*CommandExit)(nil),                   // 6: encore.daemon.CommandExit
+	(*ExecScriptResult)(nil),              // 7: encore.daemon.ExecScriptResult
+	(*CommandArtifact)(nil),               // 8: encore.daemon.CommandArtifact
+	(*CommandDisplayErrors)(nil),          // 9: encore.daemon.CommandDisplayErrors
+	(*RunRequest)(nil),                    // 10: encore.daemon.RunRequest
+	(*TestRequest)(nil),                   // 11: encore.daemon.TestRequest
+	(*TestSpecRequest)(nil),               // 12: encore.daemon.TestSpecRequest
+	(*TestSpecResponse)(nil),              // 13: encore.daemon.TestSpecResponse
+	(*TerminalSize)(nil),                  // 14: encore.daemon.TerminalSize
+	(*ExecScriptRequest)(nil),             // 15: encore.daemon.ExecScriptRequest
+	(*ExecScriptResumeRequest)(nil),       // 16: encore.daemon.ExecScriptResumeRequest
+	(*ExecScriptCancelRequest)(nil),       // 17: encore.daemon.ExecScriptCancelRequest
+	(*ExecScriptSessionRequest)(nil),      // 18: encore.daemon.ExecScriptSessionRequest
+	(*ExecScriptCommand)(nil),             // 19: encore.daemon.ExecScriptCommand
+	(*CheckRequest)(nil),                  // 20: encore.daemon.CheckRequest
+	(*ExportRequest)(nil),                 // 21: encore.daemon.ExportRequest
+	(*DockerExportParams)(nil),            // 22: encore.daemon.DockerExportParams
+	(*DBConnectRequest)(nil),              // 23: encore.daemon.DBConnectRequest
+	(*DBConnectResponse)(nil),             // 24: encore.daemon.DBConnectResponse
+	(*DBProxyRequest)(nil),                // 25: encore.daemon.DBProxyRequest
+	(*DBResetRequest)(nil),                // 26: encore.daemon.DBResetRequest
+	(*GenClientRequest)(nil),              // 27: encore.daemon.GenClientRequest
+	(*GenClientResponse)(nil),             // 28: encore.daemon.GenClientResponse
+	(*GenWrappersRequest)(nil),            // 29: encore.daemon.GenWrappersRequest
+	(*GenWrappersResponse)(nil),           // 30: encore.daemon.GenWrappersResponse
+	(*SecretsRefreshRequest)(nil),         // 31: encore.daemon.SecretsRefreshRequest
+	(*SecretsRefreshResponse)(nil),        // 32: encore.daemon.SecretsRefreshResponse
+	(*VersionResponse)(nil),               // 33: encore.daemon.VersionResponse
+	(*Namespace)(nil),                     // 34: encore.daemon.Namespace
+	(*CreateNamespaceRequest)(nil),        // 35: encore.daemon.CreateNamespaceRequest
+	(*SwitchNamespaceRequest)(nil),        // 36: encore.daemon.SwitchNamespaceRequest
+	(*ListNamespacesRequest)(nil),         // 37: encore.daemon.ListNamespacesRequest
+	(*DeleteNamespaceRequest)(nil),        // 38: encore.daemon.DeleteNamespaceRequest
+	(*ListNamespacesResponse)(nil),        // 39: encore.daemon.ListNamespacesResponse
+	(*TelemetryConfig)(nil),               // 40: encore.daemon.TelemetryConfig
+	(*DumpMetaRequest)(nil),               // 41: encore.daemon.DumpMetaRequest
+	(*DumpMetaResponse)(nil),              // 42: encore.daemon.DumpMetaResponse
+	(*SQLCPlugin)(nil),                    // 43: encore.daemon.SQLCPlugin
+	nil,                                   // 44: encore.daemon.ExecScriptRequest.ContextValuesEntry
+	nil,                                   // 45: encore.daemon.ExecScriptRequest.SecretOverridesEntry
+	(*SQLCPlugin_File)(nil),               // 46: encore.daemon.SQLCPlugin.File
+	(*SQLCPlugin_Settings)(nil),           // 47: encore.daemon.SQLCPlugin.Settings
+	(*SQLCPlugin_Codegen)(nil),            // 48: encore.daemon.SQLCPlugin.Codegen
+	(*SQLCPlugin_Catalog)(nil),            // 49: encore.daemon.SQLCPlugin.Catalog
+	(*SQLCPlugin_Schema)(nil),             // 50: encore.daemon.SQLCPlugin.Schema
+	(*SQLCPlugin_CompositeType)(nil),      // 51: encore.daemon.SQLCPlugin.CompositeType
+	(*SQLCPlugin_Enum)(nil),               // 52: encore.daemon.SQLCPlugin.Enum
+	(*SQLCPlugin_Table)(nil),              // 53: encore.daemon.SQLCPlugin.Table
+	(*SQLCPlugin_Identifier)(nil),         // 54: encore.daemon.SQLCPlugin.Identifier
+	(*SQLCPlugin_Column)(nil),             // 55: encore.daemon.SQLCPlugin.Column
+	(*SQLCPlugin_Query)(nil),              // 56: encore.daemon.SQLCPlugin.Query
+	(*SQLCPlugin_Parameter)(nil),          // 57: encore.daemon.SQLCPlugin.Parameter
+	(*SQLCPlugin_GenerateRequest)(nil),    // 58: encore.daemon.SQLCPlugin.GenerateRequest
+	(*SQLCPlugin_GenerateResponse)(nil),   // 59: encore.daemon.SQLCPlugin.GenerateResponse
+	(*SQLCPlugin_Codegen_Process)(nil),    // 60: encore.daemon.SQLCPlugin.Codegen.Process
+	(*SQLCPlugin_Codegen_WASM)(nil),       // 61: encore.daemon.SQLCPlugin.Codegen.WASM
+	(*durationpb.Duration)(nil),           // 62: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),         // 63: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 64: google.protobuf.Empty
 }
 var file_encore_daemon_daemon_proto_depIdxs = []int32{
-	4,  // 0: encore.daemon.CommandMessage.output:type_name -> encore.daemon.CommandOutput
-	5,  // 1: encore.daemon.CommandMessage.exit:type_name -> encore.daemon.CommandExit
-	6,  // 2: encore.daemon.CommandMessage.errors:type_name -> encore.daemon.CommandDisplayErrors
-	1,  // 3: encore.daemon.RunRequest.browser:type_name -> encore.daemon.RunRequest.BrowserMode
-	14, // 4: encore.daemon.ExportRequest.docker:type_name -> encore.daemon.DockerExportParams
-	0,  // 5: encore.daemon.DBConnectRequest.cluster_type:type_name -> encore.daemon.DBClusterType
-	0,  // 6: encore.daemon.DBProxyRequest.cluster_type:type_name -> encore.daemon.DBClusterType
-	0,  // 7: encore.daemon.DBResetRequest.cluster_type:type_name -> encore.daemon.DBClusterType
-	26, // 8: encore.daemon.ListNamespacesResponse.namespaces:type_name -> encore.daemon.Namespace
-	2,  // 9: encore.daemon.DumpMetaRequest.format:type_name -> encore.daemon.DumpMetaRequest.Format
-	38, // 10: encore.daemon.SQLCPlugin.Settings.codegen:type_name -> encore.daemon.SQLCPlugin.Codegen
-	50, // 11: encore.daemon.SQLCPlugin.Codegen.process:type_name -> encore.daemon.SQLCPlugin.Codegen.Process
-	51, // 12: encore.daemon.SQLCPlugin.Codegen.wasm:type_name -> encore.daemon.SQLCPlugin.Codegen.WASM
-	40, // 13: encore.daemon.SQLCPlugin.Catalog.schemas:type_name -> encore.daemon.SQLCPlugin.Schema
-	43, // 14: encore.daemon.SQLCPlugin.Schema.tables:type_name -> encore.daemon.SQLCPlugin.Table
-	42, // 15: encore.daemon.SQLCPlugin.Schema.enums:type_name -> encore.daemon.SQLCPlugin.Enum
-	41, // 16: encore.daemon.SQLCPlugin.Schema.composite_types:type_name -> encore.daemon.SQLCPlugin.CompositeType
-	44, // 17: encore.daemon.SQLCPlugin.Table.rel:type_name -> encore.daemon.SQLCPlugin.Identifier
-	45, // 18: encore.daemon.SQLCPlugin.Table.columns:type_name -> encore.daemon.SQLCPlugin.Column
-	44, // 19: encore.daemon.SQLCPlugin.Column.table:type_name -> encore.daemon.SQLCPlugin.Identifier
-	44, // 20: encore.daemon.SQLCPlugin.Column.type:type_name -> encore.daemon.SQLCPlugin.Identifier
-	44, // 21: encore.daemon.SQLCPlugin.Column.embed_table:type_name -> encore.daemon.SQLCPlugin.Identifier
-	45, // 22: encore.daemon.SQLCPlugin.Query.columns:type_name -> encore.daemon.SQLCPlugin.Column
-	47, // 23: encore.daemon.SQLCPlugin.Query.params:type_name -> encore.daemon.SQLCPlugin.Parameter
-	44, // 24: encore.daemon.SQLCPlugin.Query.insert_into_table:type_name -> encore.daemon.SQLCPlugin.Identifier
-	45, // 25: encore.daemon.SQLCPlugin.Parameter.column:type_name -> encore.daemon.SQLCPlugin.Column
-	37, // 26: encore.daemon.SQLCPlugin.GenerateRequest.settings:type_name -> encore.daemon.SQLCPlugin.Settings
-	39, // 27: encore.daemon.SQLCPlugin.GenerateRequest.catalog:type_name -> encore.daemon.SQLCPlugin.Catalog
-	46, // 28: encore.daemon.SQLCPlugin.GenerateRequest.queries:type_name -> encore.daemon.SQLCPlugin.Query
-	36, // 29: encore.daemon.SQLCPlugin.GenerateResponse.files:type_name -> encore.daemon.SQLCPlugin.File
-	7,  // 30: encore.daemon.Daemon.Run:input_type -> encore.daemon.RunRequest
-	8,  // 31: encore.daemon.Daemon.Test:input_type -> encore.daemon.TestRequest
-	9,  // 32: encore.daemon.Daemon.TestSpec:input_type -> encore.daemon.TestSpecRequest
-	11, // 33: encore.daemon.Daemon.ExecScript:input_type -> encore.daemon.ExecScriptRequest
-	12, // 34: encore.daemon.Daemon.Check:input_type -> encore.daemon.CheckRequest
-	13, // 35: encore.daemon.Daemon.Export:input_type -> encore.daemon.ExportRequest
-	15, // 36: encore.daemon.Daemon.DBConnect:input_type -> encore.daemon.DBConnectRequest
-	17, // 37: encore.daemon.Daemon.DBProxy:input_type -> encore.daemon.DBProxyRequest
-	18, // 38: encore.daemon.Daemon.DBReset:input_type -> encore.daemon.DBResetRequest
-	19, // 39: encore.daemon.Daemon.GenClient:input_type -> encore.daemon.GenClientRequest
-	21, // 40: encore.daemon.Daemon.GenWrappers:input_type -> encore.daemon.GenWrappersRequest
-	23, // 41: encore.daemon.Daemon.SecretsRefresh:input_type -> encore.daemon.SecretsRefreshRequest
-	52, // 42: encore.daemon.Daemon.Version:input_type -> google.protobuf.Empty
-	27, // 43: encore.daemon.Daemon.CreateNamespace:input_type -> encore.daemon.CreateNamespaceRequest
-	28, // 44: encore.daemon.Daemon.SwitchNamespace:input_type -> encore.daemon.SwitchNamespaceRequest
-	29, // 45: encore.daemon.Daemon.ListNamespaces:input_type -> encore.daemon.ListNamespacesRequest
-	30, // 46: encore.daemon.Daemon.DeleteNamespace:input_type -> encore.daemon.DeleteNamespaceRequest
-	33, // 47: encore.daemon.Daemon.DumpMeta:input_type -> encore.daemon.DumpMetaRequest
-	32, // 48: encore.daemon.Daemon.Telemetry:input_type -> encore.daemon.TelemetryConfig
-	3,  // 49: encore.daemon.Daemon.Run:output_type -> encore.daemon.CommandMessage
-	3,  // 50: encore.daemon.Daemon.Test:output_type -> encore.daemon.CommandMessage
-	10, // 51: encore.daemon.Daemon.TestSpec:output_type -> encore.daemon.TestSpecResponse
-	3,  // 52: encore.daemon.Daemon.ExecScript:output_type -> encore.daemon.CommandMessage
-	3,  // 53: encore.daemon.Daemon.Check:output_type -> encore.daemon.CommandMessage
-	3,  // 54: encore.daemon.Daemon.Export:output_type -> encore.daemon.CommandMessage
-	16, // 55: encore.daemon.Daemon.DBConnect:output_type -> encore.daemon.DBConnectResponse
-	3,  // 56: encore.daemon.Daemon.DBProxy:output_type -> encore.daemon.CommandMessage
-	3,  // 57: encore.daemon.Daemon.DBReset:output_type -> encore.daemon.CommandMessage
-	20, // 58: encore.daemon.Daemon.GenClient:output_type -> encore.daemon.GenClientResponse
-	22, // 59: encore.daemon.Daemon.GenWrappers:output_type -> encore.daemon.GenWrappersResponse
-	24, // 60: encore.daemon.Daemon.SecretsRefresh:output_type -> encore.daemon.SecretsRefreshResponse
-	25, // 61: encore.daemon.Daemon.Version:output_type -> encore.daemon.VersionResponse
-	26, // 62: encore.daemon.Daemon.CreateNamespace:output_type -> encore.daemon.Namespace
-	26, // 63: encore.daemon.Daemon.SwitchNamespace:output_type -> encore.daemon.Namespace
-	31, // 64: encore.daemon.Daemon.ListNamespaces:output_type -> encore.daemon.ListNamespacesResponse
-	52, // 65: encore.daemon.Daemon.DeleteNamespace:output_type -> google.protobuf.Empty
-	34, // 66: encore.daemon.Daemon.DumpMeta:output_type -> encore.daemon.DumpMetaResponse
-	52, // 67: encore.daemon.Daemon.Telemetry:output_type -> google.protobuf.Empty
-	49, // [49:68] is the sub-list for method output_type
-	30, // [30:49] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	5,  // 0: encore.daemon.CommandMessage.output:type_name -> encore.daemon.CommandOutput
+	6,  // 1: encore.daemon.CommandMessage.exit:type_name -> encore.daemon.CommandExit
+	9,  // 2: encore.daemon.CommandMessage.errors:type_name -> encore.daemon.CommandDisplayErrors
+	8,  // 3: encore.daemon.CommandMessage.artifact:type_name -> encore.daemon.CommandArtifact
+	7,  // 4: encore.daemon.CommandExit.exec_result:type_name -> encore.daemon.ExecScriptResult
+	62, // 5: encore.daemon.ExecScriptResult.build_duration:type_name -> google.protobuf.Duration
+	62, // 6: encore.daemon.ExecScriptResult.cpu_time:type_name -> google.protobuf.Duration
+	1,  // 7: encore.daemon.RunRequest.browser:type_name -> encore.daemon.RunRequest.BrowserMode
+	2,  // 8: encore.daemon.ExecScriptRequest.build_verbosity:type_name -> encore.daemon.ExecScriptRequest.BuildVerbosity
+	44, // 9: encore.daemon.ExecScriptRequest.context_values:type_name -> encore.daemon.ExecScriptRequest.ContextValuesEntry
+	45, // 10: encore.daemon.ExecScriptRequest.secret_overrides:type_name -> encore.daemon.ExecScriptRequest.SecretOverridesEntry
+	14, // 11: encore.daemon.ExecScriptRequest.tty:type_name -> encore.daemon.TerminalSize
+	63, // 12: encore.daemon.ExecScriptResumeRequest.since_time:type_name -> google.protobuf.Timestamp
+	15, // 13: encore.daemon.ExecScriptSessionRequest.start:type_name -> encore.daemon.ExecScriptRequest
+	19, // 14: encore.daemon.ExecScriptSessionRequest.command:type_name -> encore.daemon.ExecScriptCommand
+	22, // 15: encore.daemon.ExportRequest.docker:type_name -> encore.daemon.DockerExportParams
+	0,  // 16: encore.daemon.DBConnectRequest.cluster_type:type_name -> encore.daemon.DBClusterType
+	0,  // 17: encore.daemon.DBProxyRequest.cluster_type:type_name -> encore.daemon.DBClusterType
+	0,  // 18: encore.daemon.DBResetRequest.cluster_type:type_name -> encore.daemon.DBClusterType
+	34, // 19: encore.daemon.ListNamespacesResponse.namespaces:type_name -> encore.daemon.Namespace
+	3,  // 20: encore.daemon.DumpMetaRequest.format:type_name -> encore.daemon.DumpMetaRequest.Format
+	48, // 21: encore.daemon.SQLCPlugin.Settings.codegen:type_name -> encore.daemon.SQLCPlugin.Codegen
+	60, // 22: encore.daemon.SQLCPlugin.Codegen.process:type_name -> encore.daemon.SQLCPlugin.Codegen.Process
+	61, // 23: encore.daemon.SQLCPlugin.Codegen.wasm:type_name -> encore.daemon.SQLCPlugin.Codegen.WASM
+	50, // 24: encore.daemon.SQLCPlugin.Catalog.schemas:type_name -> encore.daemon.SQLCPlugin.Schema
+	53, // 25: encore.daemon.SQLCPlugin.Schema.tables:type_name -> encore.daemon.SQLCPlugin.Table
+	52, // 26: encore.daemon.SQLCPlugin.Schema.enums:type_name -> encore.daemon.SQLCPlugin.Enum
+	51, // 27: encore.daemon.SQLCPlugin.Schema.composite_types:type_name -> encore.daemon.SQLCPlugin.CompositeType
+	54, // 28: encore.daemon.SQLCPlugin.Table.rel:type_name -> encore.daemon.SQLCPlugin.Identifier
+	55, // 29: encore.daemon.SQLCPlugin.Table.columns:type_name -> encore.daemon.SQLCPlugin.Column
+	54, // 30: encore.daemon.SQLCPlugin.Column.table:type_name -> encore.daemon.SQLCPlugin.Identifier
+	54, // 31: encore.daemon.SQLCPlugin.Column.type:type_name -> encore.daemon.SQLCPlugin.Identifier
+	54, // 32: encore.daemon.SQLCPlugin.Column.embed_table:type_name -> encore.daemon.SQLCPlugin.Identifier
+	55, // 33: encore.daemon.SQLCPlugin.Query.columns:type_name -> encore.daemon.SQLCPlugin.Column
+	57, // 34: encore.daemon.SQLCPlugin.Query.params:type_name -> encore.daemon.SQLCPlugin.Parameter
+	54, // 35: encore.daemon.SQLCPlugin.Query.insert_into_table:type_name -> encore.daemon.SQLCPlugin.Identifier
+	55, // 36: encore.daemon.SQLCPlugin.Parameter.column:type_name -> encore.daemon.SQLCPlugin.Column
+	47, // 37: encore.daemon.SQLCPlugin.GenerateRequest.settings:type_name -> encore.daemon.SQLCPlugin.Settings
+	49, // 38: encore.daemon.SQLCPlugin.GenerateRequest.catalog:type_name -> encore.daemon.SQLCPlugin.Catalog
+	56, // 39: encore.daemon.SQLCPlugin.GenerateRequest.queries:type_name -> encore.daemon.SQLCPlugin.Query
+	46, // 40: encore.daemon.SQLCPlugin.GenerateResponse.files:type_name -> encore.daemon.SQLCPlugin.File
+	10, // 41: encore.daemon.Daemon.Run:input_type -> encore.daemon.RunRequest
+	11, // 42: encore.daemon.Daemon.Test:input_type -> encore.daemon.TestRequest
+	12, // 43: encore.daemon.Daemon.TestSpec:input_type -> encore.daemon.TestSpecRequest
+	15, // 44: encore.daemon.Daemon.ExecScript:input_type -> encore.daemon.ExecScriptRequest
+	18, // 45: encore.daemon.Daemon.ExecScriptSession:input_type -> encore.daemon.ExecScriptSessionRequest
+	16, // 46: encore.daemon.Daemon.ExecScriptResume:input_type -> encore.daemon.ExecScriptResumeRequest
+	17, // 47: encore.daemon.Daemon.ExecScriptCancel:input_type -> encore.daemon.ExecScriptCancelRequest
+	20, // 48: encore.daemon.Daemon.Check:input_type -> encore.daemon.CheckRequest
+	21, // 49: encore.daemon.Daemon.Export:input_type -> encore.daemon.ExportRequest
+	23, // 50: encore.daemon.Daemon.DBConnect:input_type -> encore.daemon.DBConnectRequest
+	25, // 51: encore.daemon.Daemon.DBProxy:input_type -> encore.daemon.DBProxyRequest
+	26, // 52: encore.daemon.Daemon.DBReset:input_type -> encore.daemon.DBResetRequest
+	27, // 53: encore.daemon.Daemon.GenClient:input_type -> encore.daemon.GenClientRequest
+	29, // 54: encore.daemon.Daemon.GenWrappers:input_type -> encore.daemon.GenWrappersRequest
+	31, // 55: encore.daemon.Daemon.SecretsRefresh:input_type -> encore.daemon.SecretsRefreshRequest
+	64, // 56: encore.daemon.Daemon.Version:input_type -> google.protobuf.Empty
+	35, // 57: encore.daemon.Daemon.CreateNamespace:input_type -> encore.daemon.CreateNamespaceRequest
+	36, // 58: encore.daemon.Daemon.SwitchNamespace:input_type -> encore.daemon.SwitchNamespaceRequest
+	37, // 59: encore.daemon.Daemon.ListNamespaces:input_type -> encore.daemon.ListNamespacesRequest
+	38, // 60: encore.daemon.Daemon.DeleteNamespace:input_type -> encore.daemon.DeleteNamespaceRequest
+	41, // 61: encore.daemon.Daemon.DumpMeta:input_type -> encore.daemon.DumpMetaRequest
+	40, // 62: encore.daemon.Daemon.Telemetry:input_type -> encore.daemon.TelemetryConfig
+	4,  // 63: encore.daemon.Daemon.Run:output_type -> encore.daemon.CommandMessage
+	4,  // 64: encore.daemon.Daemon.Test:output_type -> encore.daemon.CommandMessage
+	13, // 65: encore.daemon.Daemon.TestSpec:output_type -> encore.daemon.TestSpecResponse
+	4,  // 66: encore.daemon.Daemon.ExecScript:output_type -> encore.daemon.CommandMessage
+	4,  // 67: encore.daemon.Daemon.ExecScriptSession:output_type -> encore.daemon.CommandMessage
+	4,  // 68: encore.daemon.Daemon.ExecScriptResume:output_type -> encore.daemon.CommandMessage
+	64, // 69: encore.daemon.Daemon.ExecScriptCancel:output_type -> google.protobuf.Empty
+	4,  // 70: encore.daemon.Daemon.Check:output_type -> encore.daemon.CommandMessage
+	4,  // 71: encore.daemon.Daemon.Export:output_type -> encore.daemon.CommandMessage
+	24, // 72: encore.daemon.Daemon.DBConnect:output_type -> encore.daemon.DBConnectResponse
+	4,  // 73: encore.daemon.Daemon.DBProxy:output_type -> encore.daemon.CommandMessage
+	4,  // 74: encore.daemon.Daemon.DBReset:output_type -> encore.daemon.CommandMessage
+	28, // 75: encore.daemon.Daemon.GenClient:output_type -> encore.daemon.GenClientResponse
+	30, // 76: encore.daemon.Daemon.GenWrappers:output_type -> encore.daemon.GenWrappersResponse
+	32, // 77: encore.daemon.Daemon.SecretsRefresh:output_type -> encore.daemon.SecretsRefreshResponse
+	33, // 78: encore.daemon.Daemon.Version:output_type -> encore.daemon.VersionResponse
+	34, // 79: encore.daemon.Daemon.CreateNamespace:output_type -> encore.daemon.Namespace
+	34, // 80: encore.daemon.Daemon.SwitchNamespace:output_type -> encore.daemon.Namespace
+	39, // 81: encore.daemon.Daemon.ListNamespaces:output_type -> encore.daemon.ListNamespacesResponse
+	64, // 82: encore.daemon.Daemon.DeleteNamespace:output_type -> google.protobuf.Empty
+	42, // 83: encore.daemon.Daemon.DumpMeta:output_type -> encore.daemon.DumpMetaResponse
+	64, // 84: encore.daemon.Daemon.Telemetry:output_type -> google.protobuf.Empty
+	63, // [63:85] is the sub-list for method output_type
+	41, // [41:63] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_encore_daemon_daemon_proto_init() }
@@ -4286,7 +5461,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommandDisplayErrors); i {
+			switch v := v.(*ExecScriptResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4298,7 +5473,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunRequest); i {
+			switch v := v.(*CommandArtifact); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4310,7 +5485,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestRequest); i {
+			switch v := v.(*CommandDisplayErrors); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4322,7 +5497,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestSpecRequest); i {
+			switch v := v.(*RunRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4334,7 +5509,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestSpecResponse); i {
+			switch v := v.(*TestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4346,7 +5521,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecScriptRequest); i {
+			switch v := v.(*TestSpecRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4358,7 +5533,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckRequest); i {
+			switch v := v.(*TestSpecResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4370,7 +5545,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportRequest); i {
+			switch v := v.(*TerminalSize); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4382,7 +5557,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DockerExportParams); i {
+			switch v := v.(*ExecScriptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4394,7 +5569,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DBConnectRequest); i {
+			switch v := v.(*ExecScriptResumeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4406,7 +5581,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DBConnectResponse); i {
+			switch v := v.(*ExecScriptCancelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4418,7 +5593,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DBProxyRequest); i {
+			switch v := v.(*ExecScriptSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4430,7 +5605,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DBResetRequest); i {
+			switch v := v.(*ExecScriptCommand); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4442,7 +5617,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenClientRequest); i {
+			switch v := v.(*CheckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4454,7 +5629,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenClientResponse); i {
+			switch v := v.(*ExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4466,7 +5641,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenWrappersRequest); i {
+			switch v := v.(*DockerExportParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4478,7 +5653,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenWrappersResponse); i {
+			switch v := v.(*DBConnectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4490,7 +5665,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretsRefreshRequest); i {
+			switch v := v.(*DBConnectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4502,7 +5677,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretsRefreshResponse); i {
+			switch v := v.(*DBProxyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4514,7 +5689,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionResponse); i {
+			switch v := v.(*DBResetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4526,7 +5701,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Namespace); i {
+			switch v := v.(*GenClientRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4538,7 +5713,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateNamespaceRequest); i {
+			switch v := v.(*GenClientResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4550,7 +5725,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwitchNamespaceRequest); i {
+			switch v := v.(*GenWrappersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4562,7 +5737,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListNamespacesRequest); i {
+			switch v := v.(*GenWrappersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4574,7 +5749,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteNamespaceRequest); i {
+			switch v := v.(*SecretsRefreshRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4586,7 +5761,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListNamespacesResponse); i {
+			switch v := v.(*SecretsRefreshResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4598,7 +5773,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TelemetryConfig); i {
+			switch v := v.(*VersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4610,7 +5785,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DumpMetaRequest); i {
+			switch v := v.(*Namespace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4622,7 +5797,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DumpMetaResponse); i {
+			switch v := v.(*CreateNamespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4634,7 +5809,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLCPlugin); i {
+			switch v := v.(*SwitchNamespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4646,7 +5821,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLCPlugin_File); i {
+			switch v := v.(*ListNamespacesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4658,7 +5833,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLCPlugin_Settings); i {
+			switch v := v.(*DeleteNamespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4670,7 +5845,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLCPlugin_Codegen); i {
+			switch v := v.(*ListNamespacesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4682,7 +5857,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLCPlugin_Catalog); i {
+			switch v := v.(*TelemetryConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4694,7 +5869,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLCPlugin_Schema); i {
+			switch v := v.(*DumpMetaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4706,7 +5881,7 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLCPlugin_CompositeType); i {
+			switch v := v.(*DumpMetaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4718,6 +5893,90 @@ func file_encore_daemon_daemon_proto_init() {
 			}
 		}
 		file_encore_daemon_daemon_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLCPlugin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encore_daemon_daemon_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLCPlugin_File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encore_daemon_daemon_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLCPlugin_Settings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encore_daemon_daemon_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLCPlugin_Codegen); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encore_daemon_daemon_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLCPlugin_Catalog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encore_daemon_daemon_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLCPlugin_Schema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encore_daemon_daemon_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SQLCPlugin_CompositeType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encore_daemon_daemon_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SQLCPlugin_Enum); i {
 			case 0:
 				return &v.state
@@ -4729,7 +5988,7 @@ func file_encore_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_encore_daemon_daemon_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_encore_daemon_daemon_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SQLCPlugin_Table); i {
 			case 0:
 				return &v.state
@@ -4741,7 +6000,7 @@ func file_encore_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_encore_daemon_daemon_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_encore_daemon_daemon_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SQLCPlugin_Identifier); i {
 			case 0:
 				return &v.state
@@ -4753,7 +6012,7 @@ func file_encore_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_encore_daemon_daemon_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_encore_daemon_daemon_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SQLCPlugin_Column); i {
 			case 0:
 				return &v.state
@@ -4765,7 +6024,7 @@ func file_encore_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_encore_daemon_daemon_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_encore_daemon_daemon_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SQLCPlugin_Query); i {
 			case 0:
 				return &v.state
@@ -4777,7 +6036,7 @@ func file_encore_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_encore_daemon_daemon_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_encore_daemon_daemon_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SQLCPlugin_Parameter); i {
 			case 0:
 				return &v.state
@@ -4789,7 +6048,7 @@ func file_encore_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_encore_daemon_daemon_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_encore_daemon_daemon_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SQLCPlugin_GenerateRequest); i {
 			case 0:
 				return &v.state
@@ -4801,7 +6060,7 @@ func file_encore_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_encore_daemon_daemon_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_encore_daemon_daemon_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SQLCPlugin_GenerateResponse); i {
 			case 0:
 				return &v.state
@@ -4813,7 +6072,7 @@ func file_encore_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_encore_daemon_daemon_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_encore_daemon_daemon_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SQLCPlugin_Codegen_Process); i {
 			case 0:
 				return &v.state
@@ -4825,7 +6084,7 @@ func file_encore_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_encore_daemon_daemon_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_encore_daemon_daemon_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SQLCPlugin_Codegen_WASM); i {
 			case 0:
 				return &v.state
@@ -4842,24 +6101,35 @@ func file_encore_daemon_daemon_proto_init() {
 		(*CommandMessage_Output)(nil),
 		(*CommandMessage_Exit)(nil),
 		(*CommandMessage_Errors)(nil),
-	}
-	file_encore_daemon_daemon_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_encore_daemon_daemon_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_encore_daemon_daemon_proto_msgTypes[8].OneofWrappers = []interface{}{}
-	file_encore_daemon_daemon_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*CommandMessage_Artifact)(nil),
+	}
+	file_encore_daemon_daemon_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_encore_daemon_daemon_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_encore_daemon_daemon_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_encore_daemon_daemon_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_encore_daemon_daemon_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_encore_daemon_daemon_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*ExecScriptResumeRequest_SinceSeq)(nil),
+		(*ExecScriptResumeRequest_SinceTime)(nil),
+	}
+	file_encore_daemon_daemon_proto_msgTypes[14].OneofWrappers = []interface{}{
+		(*ExecScriptSessionRequest_Start)(nil),
+		(*ExecScriptSessionRequest_Command)(nil),
+	}
+	file_encore_daemon_daemon_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*ExportRequest_Docker)(nil),
 	}
-	file_encore_daemon_daemon_proto_msgTypes[12].OneofWrappers = []interface{}{}
-	file_encore_daemon_daemon_proto_msgTypes[14].OneofWrappers = []interface{}{}
-	file_encore_daemon_daemon_proto_msgTypes[15].OneofWrappers = []interface{}{}
-	file_encore_daemon_daemon_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_encore_daemon_daemon_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_encore_daemon_daemon_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_encore_daemon_daemon_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_encore_daemon_daemon_proto_msgTypes[30].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_encore_daemon_daemon_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   49,
+			NumEnums:      4,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
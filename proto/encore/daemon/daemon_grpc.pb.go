@@ -20,25 +20,28 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Daemon_Run_FullMethodName             = "/encore.daemon.Daemon/Run"
-	Daemon_Test_FullMethodName            = "/encore.daemon.Daemon/Test"
-	Daemon_TestSpec_FullMethodName        = "/encore.daemon.Daemon/TestSpec"
-	Daemon_ExecScript_FullMethodName      = "/encore.daemon.Daemon/ExecScript"
-	Daemon_Check_FullMethodName           = "/encore.daemon.Daemon/Check"
-	Daemon_Export_FullMethodName          = "/encore.daemon.Daemon/Export"
-	Daemon_DBConnect_FullMethodName       = "/encore.daemon.Daemon/DBConnect"
-	Daemon_DBProxy_FullMethodName         = "/encore.daemon.Daemon/DBProxy"
-	Daemon_DBReset_FullMethodName         = "/encore.daemon.Daemon/DBReset"
-	Daemon_GenClient_FullMethodName       = "/encore.daemon.Daemon/GenClient"
-	Daemon_GenWrappers_FullMethodName     = "/encore.daemon.Daemon/GenWrappers"
-	Daemon_SecretsRefresh_FullMethodName  = "/encore.daemon.Daemon/SecretsRefresh"
-	Daemon_Version_FullMethodName         = "/encore.daemon.Daemon/Version"
-	Daemon_CreateNamespace_FullMethodName = "/encore.daemon.Daemon/CreateNamespace"
-	Daemon_SwitchNamespace_FullMethodName = "/encore.daemon.Daemon/SwitchNamespace"
-	Daemon_ListNamespaces_FullMethodName  = "/encore.daemon.Daemon/ListNamespaces"
-	Daemon_DeleteNamespace_FullMethodName = "/encore.daemon.Daemon/DeleteNamespace"
-	Daemon_DumpMeta_FullMethodName        = "/encore.daemon.Daemon/DumpMeta"
-	Daemon_Telemetry_FullMethodName       = "/encore.daemon.Daemon/Telemetry"
+	Daemon_Run_FullMethodName               = "/encore.daemon.Daemon/Run"
+	Daemon_Test_FullMethodName              = "/encore.daemon.Daemon/Test"
+	Daemon_TestSpec_FullMethodName          = "/encore.daemon.Daemon/TestSpec"
+	Daemon_ExecScript_FullMethodName        = "/encore.daemon.Daemon/ExecScript"
+	Daemon_ExecScriptSession_FullMethodName = "/encore.daemon.Daemon/ExecScriptSession"
+	Daemon_ExecScriptResume_FullMethodName  = "/encore.daemon.Daemon/ExecScriptResume"
+	Daemon_ExecScriptCancel_FullMethodName  = "/encore.daemon.Daemon/ExecScriptCancel"
+	Daemon_Check_FullMethodName             = "/encore.daemon.Daemon/Check"
+	Daemon_Export_FullMethodName            = "/encore.daemon.Daemon/Export"
+	Daemon_DBConnect_FullMethodName         = "/encore.daemon.Daemon/DBConnect"
+	Daemon_DBProxy_FullMethodName           = "/encore.daemon.Daemon/DBProxy"
+	Daemon_DBReset_FullMethodName           = "/encore.daemon.Daemon/DBReset"
+	Daemon_GenClient_FullMethodName         = "/encore.daemon.Daemon/GenClient"
+	Daemon_GenWrappers_FullMethodName       = "/encore.daemon.Daemon/GenWrappers"
+	Daemon_SecretsRefresh_FullMethodName    = "/encore.daemon.Daemon/SecretsRefresh"
+	Daemon_Version_FullMethodName           = "/encore.daemon.Daemon/Version"
+	Daemon_CreateNamespace_FullMethodName   = "/encore.daemon.Daemon/CreateNamespace"
+	Daemon_SwitchNamespace_FullMethodName   = "/encore.daemon.Daemon/SwitchNamespace"
+	Daemon_ListNamespaces_FullMethodName    = "/encore.daemon.Daemon/ListNamespaces"
+	Daemon_DeleteNamespace_FullMethodName   = "/encore.daemon.Daemon/DeleteNamespace"
+	Daemon_DumpMeta_FullMethodName          = "/encore.daemon.Daemon/DumpMeta"
+	Daemon_Telemetry_FullMethodName         = "/encore.daemon.Daemon/Telemetry"
 )
 
 // DaemonClient is the client API for Daemon service.
@@ -53,6 +56,15 @@ type DaemonClient interface {
 	TestSpec(ctx context.Context, in *TestSpecRequest, opts ...grpc.CallOption) (*TestSpecResponse, error)
 	// ExecScript executes a one-off script.
 	ExecScript(ctx context.Context, in *ExecScriptRequest, opts ...grpc.CallOption) (Daemon_ExecScriptClient, error)
+	// ExecScriptSession executes a script interactively,
+	// forwarding commands sent by the client to the running script.
+	ExecScriptSession(ctx context.Context, opts ...grpc.CallOption) (Daemon_ExecScriptSessionClient, error)
+	// ExecScriptResume reattaches to a resumable script started with ExecScript,
+	// replaying its buffered output.
+	ExecScriptResume(ctx context.Context, in *ExecScriptResumeRequest, opts ...grpc.CallOption) (Daemon_ExecScriptResumeClient, error)
+	// ExecScriptCancel cancels a resumable script started with ExecScript,
+	// stopping it as if the client of a non-resumable script had disconnected.
+	ExecScriptCancel(ctx context.Context, in *ExecScriptCancelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Check checks the app for compilation errors.
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (Daemon_CheckClient, error)
 	// Export exports the app in various formats.
@@ -198,8 +210,80 @@ func (x *daemonExecScriptClient) Recv() (*CommandMessage, error) {
 	return m, nil
 }
 
+func (c *daemonClient) ExecScriptSession(ctx context.Context, opts ...grpc.CallOption) (Daemon_ExecScriptSessionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[3], Daemon_ExecScriptSession_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonExecScriptSessionClient{stream}
+	return x, nil
+}
+
+type Daemon_ExecScriptSessionClient interface {
+	Send(*ExecScriptSessionRequest) error
+	Recv() (*CommandMessage, error)
+	grpc.ClientStream
+}
+
+type daemonExecScriptSessionClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonExecScriptSessionClient) Send(m *ExecScriptSessionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *daemonExecScriptSessionClient) Recv() (*CommandMessage, error) {
+	m := new(CommandMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) ExecScriptResume(ctx context.Context, in *ExecScriptResumeRequest, opts ...grpc.CallOption) (Daemon_ExecScriptResumeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[4], Daemon_ExecScriptResume_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonExecScriptResumeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_ExecScriptResumeClient interface {
+	Recv() (*CommandMessage, error)
+	grpc.ClientStream
+}
+
+type daemonExecScriptResumeClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonExecScriptResumeClient) Recv() (*CommandMessage, error) {
+	m := new(CommandMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) ExecScriptCancel(ctx context.Context, in *ExecScriptCancelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Daemon_ExecScriptCancel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (Daemon_CheckClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[3], Daemon_Check_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[5], Daemon_Check_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -231,7 +315,7 @@ func (x *daemonCheckClient) Recv() (*CommandMessage, error) {
 }
 
 func (c *daemonClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Daemon_ExportClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[4], Daemon_Export_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[6], Daemon_Export_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -272,7 +356,7 @@ func (c *daemonClient) DBConnect(ctx context.Context, in *DBConnectRequest, opts
 }
 
 func (c *daemonClient) DBProxy(ctx context.Context, in *DBProxyRequest, opts ...grpc.CallOption) (Daemon_DBProxyClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[5], Daemon_DBProxy_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[7], Daemon_DBProxy_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -304,7 +388,7 @@ func (x *daemonDBProxyClient) Recv() (*CommandMessage, error) {
 }
 
 func (c *daemonClient) DBReset(ctx context.Context, in *DBResetRequest, opts ...grpc.CallOption) (Daemon_DBResetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[6], Daemon_DBReset_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[8], Daemon_DBReset_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -437,6 +521,15 @@ type DaemonServer interface {
 	TestSpec(context.Context, *TestSpecRequest) (*TestSpecResponse, error)
 	// ExecScript executes a one-off script.
 	ExecScript(*ExecScriptRequest, Daemon_ExecScriptServer) error
+	// ExecScriptSession executes a script interactively,
+	// forwarding commands sent by the client to the running script.
+	ExecScriptSession(Daemon_ExecScriptSessionServer) error
+	// ExecScriptResume reattaches to a resumable script started with ExecScript,
+	// replaying its buffered output.
+	ExecScriptResume(*ExecScriptResumeRequest, Daemon_ExecScriptResumeServer) error
+	// ExecScriptCancel cancels a resumable script started with ExecScript,
+	// stopping it as if the client of a non-resumable script had disconnected.
+	ExecScriptCancel(context.Context, *ExecScriptCancelRequest) (*emptypb.Empty, error)
 	// Check checks the app for compilation errors.
 	Check(*CheckRequest, Daemon_CheckServer) error
 	// Export exports the app in various formats.
@@ -486,6 +579,15 @@ func (UnimplementedDaemonServer) TestSpec(context.Context, *TestSpecRequest) (*T
 func (UnimplementedDaemonServer) ExecScript(*ExecScriptRequest, Daemon_ExecScriptServer) error {
 	return status.Errorf(codes.Unimplemented, "method ExecScript not implemented")
 }
+func (UnimplementedDaemonServer) ExecScriptSession(Daemon_ExecScriptSessionServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExecScriptSession not implemented")
+}
+func (UnimplementedDaemonServer) ExecScriptResume(*ExecScriptResumeRequest, Daemon_ExecScriptResumeServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExecScriptResume not implemented")
+}
+func (UnimplementedDaemonServer) ExecScriptCancel(context.Context, *ExecScriptCancelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecScriptCancel not implemented")
+}
 func (UnimplementedDaemonServer) Check(*CheckRequest, Daemon_CheckServer) error {
 	return status.Errorf(codes.Unimplemented, "method Check not implemented")
 }
@@ -625,6 +727,71 @@ func (x *daemonExecScriptServer) Send(m *CommandMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Daemon_ExecScriptSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DaemonServer).ExecScriptSession(&daemonExecScriptSessionServer{stream})
+}
+
+type Daemon_ExecScriptSessionServer interface {
+	Send(*CommandMessage) error
+	Recv() (*ExecScriptSessionRequest, error)
+	grpc.ServerStream
+}
+
+type daemonExecScriptSessionServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonExecScriptSessionServer) Send(m *CommandMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *daemonExecScriptSessionServer) Recv() (*ExecScriptSessionRequest, error) {
+	m := new(ExecScriptSessionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Daemon_ExecScriptResume_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExecScriptResumeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).ExecScriptResume(m, &daemonExecScriptResumeServer{stream})
+}
+
+type Daemon_ExecScriptResumeServer interface {
+	Send(*CommandMessage) error
+	grpc.ServerStream
+}
+
+type daemonExecScriptResumeServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonExecScriptResumeServer) Send(m *CommandMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Daemon_ExecScriptCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecScriptCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ExecScriptCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ExecScriptCancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ExecScriptCancel(ctx, req.(*ExecScriptCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_Check_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(CheckRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -918,6 +1085,10 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TestSpec",
 			Handler:    _Daemon_TestSpec_Handler,
 		},
+		{
+			MethodName: "ExecScriptCancel",
+			Handler:    _Daemon_ExecScriptCancel_Handler,
+		},
 		{
 			MethodName: "DBConnect",
 			Handler:    _Daemon_DBConnect_Handler,
@@ -979,6 +1150,17 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Daemon_ExecScript_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExecScriptSession",
+			Handler:       _Daemon_ExecScriptSession_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExecScriptResume",
+			Handler:       _Daemon_ExecScriptResume_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Check",
 			Handler:       _Daemon_Check_Handler,
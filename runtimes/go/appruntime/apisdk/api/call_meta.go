@@ -15,6 +15,7 @@ import (
 	"encore.dev/appruntime/exported/config"
 	"encore.dev/appruntime/exported/model"
 	"encore.dev/appruntime/shared/cloud"
+	"encore.dev/appruntime/shared/encoreenv"
 	"encore.dev/beta/errs"
 )
 
@@ -100,6 +101,11 @@ func (s *Server) metaFromAPICall(call *model.APICall) (meta CallMeta, err error)
 				MessageID:    call.Source.MsgData.MessageID,
 			}
 		}
+	} else {
+		// Calls made outside of a request, like by a one-off script run with
+		// "encore exec", are correlated by the script's session id, if any,
+		// so the traces of the requests they start are grouped.
+		meta.CorrelationID = clampTo64Chars(encoreenv.Get("ENCORE_SCRIPT_SESSION_ID"))
 	}
 
 	meta.Internal = &InternalCallMeta{
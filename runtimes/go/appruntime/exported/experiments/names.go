@@ -34,6 +34,14 @@ const (
 	// StreamTraces enables streaming traces to the Encore platform as they're happening,
 	// as opposed to waiting for the request to finish before starting the upload.
 	StreamTraces Name = "stream-traces"
+
+	// WarnIgnoredMigrations emits a warning when a package contains database migrations
+	// but isn't detected as a service, in which case the migrations are ignored.
+	WarnIgnoredMigrations Name = "warn-ignored-migrations"
+
+	// RequireServiceMigrations reports an error for each service that doesn't
+	// define a database with migrations, for apps where every service must own its schema.
+	RequireServiceMigrations Name = "require-service-migrations"
 )
 
 // Valid reports whether the given name is a known experiment.
@@ -46,7 +54,9 @@ func (x Name) Valid() bool {
 		LocalMultiProcess,
 		AuthDataRoundTrip,
 		TypeScript,
-		StreamTraces:
+		StreamTraces,
+		WarnIgnoredMigrations,
+		RequireServiceMigrations:
 		return true
 	default:
 		return false
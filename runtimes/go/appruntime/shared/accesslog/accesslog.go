@@ -0,0 +1,67 @@
+// Package accesslog logs the application's accesses to infrastructure resources,
+// such as database queries, Pub/Sub publishes and cache operations.
+//
+// It's meant for understanding the side effects of one-off scripts,
+// and is only enabled when the ENCORE_LOG_RESOURCE_ACCESS environment
+// variable is set, as by "encore exec --log-resource-access".
+package accesslog
+
+import (
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"encore.dev/appruntime/shared/encoreenv"
+)
+
+// Logger logs accesses to infrastructure resources.
+// A nil Logger, or one that isn't enabled, logs nothing.
+type Logger struct {
+	logger  zerolog.Logger
+	enabled bool
+}
+
+func NewLogger(logger zerolog.Logger) *Logger {
+	return &Logger{
+		logger:  logger,
+		enabled: encoreenv.Get("ENCORE_LOG_RESOURCE_ACCESS") != "",
+	}
+}
+
+// Enabled reports whether resource accesses are logged.
+func (l *Logger) Enabled() bool {
+	return l != nil && l.enabled
+}
+
+// DBQuery logs a query to the database dbName.
+func (l *Logger) DBQuery(dbName, query string, dur time.Duration, err error) {
+	if !l.Enabled() {
+		return
+	}
+	l.log("sqldb", dur, err).Str("db", dbName).Str("query", query).Msg("database query")
+}
+
+// PubsubPublish logs the publishing of a message to topic.
+func (l *Logger) PubsubPublish(topic, messageID string, dur time.Duration, err error) {
+	if !l.Enabled() {
+		return
+	}
+	l.log("pubsub", dur, err).Str("topic", topic).Str("message_id", messageID).Msg("pubsub publish")
+}
+
+// CacheCall logs the cache operation op on the given keys.
+func (l *Logger) CacheCall(op string, write bool, keys []string, dur time.Duration, err error) {
+	if !l.Enabled() {
+		return
+	}
+	l.log("cache", dur, err).Str("op", op).Bool("write", write).Strs("keys", keys).Msg("cache " + op)
+}
+
+// log starts a log event for an access to a resource of the given kind.
+func (l *Logger) log(kind string, dur time.Duration, err error) *zerolog.Event {
+	ev := l.logger.Debug()
+	if err != nil {
+		ev = l.logger.Warn().Err(err)
+	}
+	return ev.Str("resource_access", kind).Dur("duration", dur)
+}
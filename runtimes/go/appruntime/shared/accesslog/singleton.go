@@ -0,0 +1,9 @@
+//go:build encore_app
+
+package accesslog
+
+import (
+	"encore.dev/appruntime/shared/logging"
+)
+
+var Singleton = NewLogger(logging.RootLogger)
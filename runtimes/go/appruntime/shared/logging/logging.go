@@ -12,6 +12,7 @@ import (
 	"encore.dev/appruntime/exported/config"
 	"encore.dev/appruntime/shared/appconf"
 	"encore.dev/appruntime/shared/cloud"
+	"encore.dev/appruntime/shared/encoreenv"
 )
 
 var RootLogger = configure(appconf.Static, appconf.Runtime)
@@ -25,7 +26,13 @@ func configure(static *config.Static, runtime *config.Runtime) zerolog.Logger {
 	}
 
 	reconfigureZerologFormat(runtime)
-	return zerolog.New(logOutput).With().Timestamp().Logger()
+	logCtx := zerolog.New(logOutput).With().Timestamp()
+
+	// Group the logs of related one-off scripts, run with "encore exec".
+	if sessionID := encoreenv.Get("ENCORE_SCRIPT_SESSION_ID"); sessionID != "" {
+		logCtx = logCtx.Str("script_session_id", sessionID)
+	}
+	return logCtx.Logger()
 }
 
 func reconfigureZerologFormat(runtime *config.Runtime) {
@@ -26,3 +26,13 @@ func Meta() *AppMetadata {
 func CurrentRequest() *Request {
 	return Singleton.CurrentRequest()
 }
+
+// ScriptContextValues returns the context values passed to the running one-off
+// script, using "encore exec --context key=value". They're not attached to the
+// contexts of the script's resource calls.
+//
+// It returns nil if the code is not running as part of a script,
+// or if no context values were given.
+func ScriptContextValues() map[string]string {
+	return Singleton.ScriptContextValues()
+}
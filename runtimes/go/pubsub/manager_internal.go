@@ -9,6 +9,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/shared/accesslog"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/appruntime/shared/shutdown"
 	"encore.dev/appruntime/shared/testsupport"
@@ -24,6 +25,7 @@ type Manager struct {
 	rt         *reqtrack.RequestTracker
 	ts         *testsupport.Manager
 	rootLogger zerolog.Logger
+	access     *accesslog.Logger
 	json       jsoniter.API
 	providers  []provider
 
@@ -34,7 +36,7 @@ type Manager struct {
 }
 
 func NewManager(static *config.Static, runtime *config.Runtime, rt *reqtrack.RequestTracker,
-	ts *testsupport.Manager, rootLogger zerolog.Logger, json jsoniter.API) *Manager {
+	ts *testsupport.Manager, rootLogger zerolog.Logger, access *accesslog.Logger, json jsoniter.API) *Manager {
 	mgr := &Manager{
 		ctxs:         utils.NewContexts(context.Background()),
 		static:       static,
@@ -42,6 +44,7 @@ func NewManager(static *config.Static, runtime *config.Runtime, rt *reqtrack.Req
 		rt:           rt,
 		ts:           ts,
 		rootLogger:   rootLogger,
+		access:       access,
 		json:         json,
 		pushHandlers: make(map[types.SubscriptionID]http.HandlerFunc),
 	}
@@ -3,6 +3,7 @@ package pubsub
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"encore.dev/appruntime/exported/config"
 	"encore.dev/appruntime/exported/model"
@@ -172,10 +173,12 @@ func (t *Topic[T]) Publish(ctx context.Context, msg T) (id string, err error) {
 	}
 
 	// Publish once the rate limiter allows it
+	publishStart := time.Now()
 	if err = t.publishLimiter.Wait(ctx); err == nil {
 		// Publish to the clouds topic
 		id, err = t.topic.PublishMessage(ctx, orderingKey, attrs, data)
 	}
+	t.mgr.access.PubsubPublish(t.runtimeCfg.EncoreName, id, time.Since(publishStart), err)
 
 	// End the trace span
 	if curr.Req != nil && curr.Trace != nil {
@@ -3,6 +3,7 @@
 package pubsub
 
 import (
+	"encore.dev/appruntime/shared/accesslog"
 	"encore.dev/appruntime/shared/appconf"
 	"encore.dev/appruntime/shared/jsonapi"
 	"encore.dev/appruntime/shared/logging"
@@ -22,7 +23,7 @@ var Singleton *Manager
 func init() {
 	Singleton = NewManager(
 		appconf.Static, appconf.Runtime, reqtrack.Singleton, testsupport.Singleton,
-		logging.RootLogger, jsonapi.Default,
+		logging.RootLogger, accesslog.Singleton, jsonapi.Default,
 	)
 	shutdown.Singleton.RegisterShutdownHandler(Singleton.Shutdown)
 }
@@ -0,0 +1,28 @@
+package encore
+
+import (
+	"encoding/json"
+
+	"encore.dev/appruntime/shared/encoreenv"
+)
+
+// ScriptContextValues returns the context values passed to the running one-off
+// script, using "encore exec --context key=value". They can be used to attribute
+// changes made by the script, for example to an acting user for audit logging.
+//
+// The values are only available through this function: they're not attached
+// to the contexts of the script's resource calls or included in its traces.
+//
+// It returns nil if the code is not running as part of a script,
+// or if no context values were given.
+func (mgr *Manager) ScriptContextValues() map[string]string {
+	data := encoreenv.Get("ENCORE_SCRIPT_CONTEXT")
+	if data == "" {
+		return nil
+	}
+	var values map[string]string
+	if err := json.Unmarshal([]byte(data), &values); err != nil {
+		return nil
+	}
+	return values
+}
@@ -20,6 +20,7 @@ import (
 	"encore.dev/appruntime/exported/model"
 	"encore.dev/appruntime/exported/stack"
 	"encore.dev/appruntime/exported/trace2"
+	"encore.dev/appruntime/shared/accesslog"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/appruntime/shared/shutdown"
 	"encore.dev/appruntime/shared/syncutil"
@@ -32,6 +33,7 @@ type Manager struct {
 	runtime *config.Runtime
 	rt      *reqtrack.RequestTracker
 	ts      *testsupport.Manager
+	access  *accesslog.Logger
 	json    jsoniter.API
 
 	initTestSrv syncutil.Once
@@ -41,12 +43,13 @@ type Manager struct {
 	clients  map[string]*redis.Client
 }
 
-func NewManager(static *config.Static, runtime *config.Runtime, rt *reqtrack.RequestTracker, ts *testsupport.Manager, json jsoniter.API) *Manager {
+func NewManager(static *config.Static, runtime *config.Runtime, rt *reqtrack.RequestTracker, ts *testsupport.Manager, access *accesslog.Logger, json jsoniter.API) *Manager {
 	return &Manager{
 		static:  static,
 		runtime: runtime,
 		rt:      rt,
 		ts:      ts,
+		access:  access,
 		json:    json,
 		clients: make(map[string]*redis.Client),
 	}
@@ -208,6 +211,7 @@ func newClient[K, V any](cluster *Cluster, cfg KeyspaceConfig,
 
 	return &client[K, V]{
 		rt:        cluster.mgr.rt,
+		access:    cluster.mgr.access,
 		redis:     cluster.cl,
 		cfg:       cfg,
 		expiry:    defaultExpiry,
@@ -219,6 +223,7 @@ func newClient[K, V any](cluster *Cluster, cfg KeyspaceConfig,
 
 type client[K, V any] struct {
 	rt        *reqtrack.RequestTracker
+	access    *accesslog.Logger
 	redis     *redis.Client
 	cfg       KeyspaceConfig
 	expiry    ExpiryFunc
@@ -324,8 +329,13 @@ func (s *client[K, V]) expiryDur() time.Duration {
 
 func (c *client[K, V]) doTrace(op string, write bool, keys ...string) func(error) {
 	eventID := c.traceStart(op, write, keys...)
+	start := time.Now()
 	return func(err error) {
 		c.traceEnd(eventID, err)
+		if errors.Is(err, Miss) {
+			err = nil // a miss is an expected result, not a failure
+		}
+		c.access.CacheCall(op, write, keys, time.Since(start), err)
 	}
 }
 
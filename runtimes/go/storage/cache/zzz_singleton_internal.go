@@ -3,6 +3,7 @@
 package cache
 
 import (
+	"encore.dev/appruntime/shared/accesslog"
 	"encore.dev/appruntime/shared/appconf"
 	"encore.dev/appruntime/shared/jsonapi"
 	"encore.dev/appruntime/shared/reqtrack"
@@ -19,6 +20,6 @@ import (
 var Singleton *Manager
 
 func init() {
-	Singleton = NewManager(appconf.Static, appconf.Runtime, reqtrack.Singleton, testsupport.Singleton, jsonapi.Default)
+	Singleton = NewManager(appconf.Static, appconf.Runtime, reqtrack.Singleton, testsupport.Singleton, accesslog.Singleton, jsonapi.Default)
 	shutdown.Singleton.RegisterShutdownHandler(Singleton.Shutdown)
 }
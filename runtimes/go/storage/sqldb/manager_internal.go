@@ -7,6 +7,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/shared/accesslog"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/appruntime/shared/shutdown"
 	"encore.dev/appruntime/shared/testsupport"
@@ -17,16 +18,18 @@ type Manager struct {
 	runtime *config.Runtime
 	rt      *reqtrack.RequestTracker
 	ts      *testsupport.Manager
+	access  *accesslog.Logger
 
 	mu  sync.RWMutex
 	dbs map[string]*Database
 }
 
-func NewManager(runtime *config.Runtime, rt *reqtrack.RequestTracker, ts *testsupport.Manager) *Manager {
+func NewManager(runtime *config.Runtime, rt *reqtrack.RequestTracker, ts *testsupport.Manager, access *accesslog.Logger) *Manager {
 	return &Manager{
 		runtime: runtime,
 		rt:      rt,
 		ts:      ts,
+		access:  access,
 		dbs:     make(map[string]*Database),
 	}
 }
@@ -90,7 +93,7 @@ func (mgr *Manager) getPool(encoreName, dbNameOverride string) (pool *pgxpool.Po
 		panic("sqldb: " + err.Error())
 	}
 
-	cfg.ConnConfig.Tracer = &pgxTracer{mgr: mgr}
+	cfg.ConnConfig.Tracer = &pgxTracer{mgr: mgr, dbName: encoreName}
 	pool, err = pgxpool.NewWithConfig(context.Background(), cfg)
 	if err != nil {
 		panic("sqldb: setup db: " + err.Error())
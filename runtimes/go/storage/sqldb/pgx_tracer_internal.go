@@ -2,6 +2,7 @@ package sqldb
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 
@@ -11,7 +12,8 @@ import (
 )
 
 type pgxTracer struct {
-	mgr *Manager
+	mgr    *Manager
+	dbName string
 }
 
 type ctxKey string
@@ -22,6 +24,9 @@ const (
 	// pgxAlreadyTracedKey is a context key that indicates
 	// that the query is already traced through the sqldb integration.
 	pgxAlreadyTracedKey ctxKey = "pgx_query"
+
+	// pgxAccessKey is a context key for the query being logged, if access logging is enabled.
+	pgxAccessKey ctxKey = "pgx_access"
 )
 
 func markTraced(ctx context.Context) context.Context {
@@ -34,7 +39,17 @@ type queryValue struct {
 	startID     model.TraceEventID
 }
 
+type accessValue struct {
+	query string
+	start time.Time
+}
+
 func (t *pgxTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	// Log every query, including those already traced through the sqldb integration.
+	if t.mgr.access.Enabled() {
+		ctx = context.WithValue(ctx, pgxAccessKey, &accessValue{query: data.SQL, start: time.Now()})
+	}
+
 	if ctx.Value(pgxAlreadyTracedKey) != nil {
 		return ctx
 	}
@@ -62,6 +77,9 @@ func (t *pgxTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pg
 }
 
 func (t *pgxTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	if av, ok := ctx.Value(pgxAccessKey).(*accessValue); ok {
+		t.mgr.access.DBQuery(t.dbName, av.query, time.Since(av.start), data.Err)
+	}
 	if qv, ok := ctx.Value(pgxQueryKey).(*queryValue); ok {
 		qv.trace.DBQueryEnd(qv.eventParams, qv.startID, data.Err)
 	}
@@ -3,6 +3,7 @@
 package sqldb
 
 import (
+	"encore.dev/appruntime/shared/accesslog"
 	"encore.dev/appruntime/shared/appconf"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/appruntime/shared/shutdown"
@@ -18,6 +19,6 @@ import (
 var Singleton *Manager
 
 func init() {
-	Singleton = NewManager(appconf.Runtime, reqtrack.Singleton, testsupport.Singleton)
+	Singleton = NewManager(appconf.Runtime, reqtrack.Singleton, testsupport.Singleton, accesslog.Singleton)
 	shutdown.Singleton.RegisterShutdownHandler(Singleton.Shutdown)
 }
@@ -36,6 +36,13 @@ var (
 		"Resources can only be defined within a service.",
 	)
 
+	errServicesWithoutMigrations = errRange.Newf(
+		"Services without migrations",
+		"The app requires every service to own its database schema, but these services have no migrations directory: %s.",
+		errors.WithDetails("Add a migrations directory to each service, or disable the \"require-service-migrations\" experiment. "+
+			"For more information about how to use databases in Encore, see https://encore.dev/docs/primitives/databases"),
+	)
+
 	errETPackageUsedOutsideOfTestFile = errRange.New(
 		"Invalid use of encore.dev/et",
 		"Encore's test packages can only be used inside tests and cannot otherwise be imported.",
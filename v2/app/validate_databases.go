@@ -1,6 +1,11 @@
 package app
 
 import (
+	"go/ast"
+	"slices"
+	"strings"
+
+	"encore.dev/appruntime/exported/experiments"
 	"encr.dev/pkg/errors"
 	"encr.dev/v2/internals/parsectx"
 	"encr.dev/v2/parser"
@@ -8,18 +13,46 @@ import (
 )
 
 func (d *Desc) validateDatabases(pc *parsectx.Context, result *parser.Result) {
-	foundDBs := make(map[string]*sqldb.Database)
-
 	dbs := parser.Resources[*sqldb.Database](result)
-	for _, db := range dbs {
-		if previous, ok := foundDBs[db.Name]; ok {
+	// Check that database names are unique.
+	for _, c := range sqldb.NameCollisions(dbs) {
+		if c.LeftoverMigrationDir() {
+			// A database defined only by its package's migration directory clashing
+			// with another is typically a leftover from moving the database,
+			// so point out both migration directories.
+			pc.Errs.Add(
+				sqldb.ErrDuplicateMigrationDirs.
+					AtGoNode(dbDeclNode(c.DB), errors.AsError("migrations in "+string(c.DB.MigrationDir))).
+					AtGoNode(dbDeclNode(c.Previous), errors.AsHelp("previous migrations in "+string(c.Previous.MigrationDir))),
+			)
+		} else {
 			pc.Errs.Add(
 				sqldb.ErrDuplicateNames.
-					AtGoNode(db.AST.Args[0]).
-					AtGoNode(previous.AST.Args[0]),
+					AtGoNode(dbDeclNode(c.DB), errors.AsError("defined in package "+c.DB.Pkg.ImportPath.String())).
+					AtGoNode(dbDeclNode(c.Previous), errors.AsHelp("previously defined in package "+c.Previous.Pkg.ImportPath.String())),
 			)
 		}
-		foundDBs[db.Name] = db
+	}
+
+	// Check that declared dependencies refer to known databases.
+	for _, u := range sqldb.UnknownDependencies(dbs) {
+		pc.Errs.Add(sqldb.ErrDatabaseNotFound(u.Dep).AtGoNode(dbDeclNode(u.DB), errors.AsError("declared as a dependency here")))
+	}
+
+	// Check that every service defines a database, if required.
+	if experiments.RequireServiceMigrations.Enabled(pc.Build.Experiments) {
+		var missing []string
+		for _, svc := range d.Services {
+			ownsDB := slices.ContainsFunc(dbs, func(db *sqldb.Database) bool {
+				return svc.ContainsPackage(db.Pkg)
+			})
+			if !ownsDB {
+				missing = append(missing, svc.Name)
+			}
+		}
+		if len(missing) > 0 {
+			pc.Errs.Add(errServicesWithoutMigrations(strings.Join(missing, ", ")))
+		}
 	}
 
 	// Check for usages outside of services
@@ -33,3 +66,16 @@ func (d *Desc) validateDatabases(pc *parsectx.Context, result *parser.Result) {
 		}
 	}
 }
+
+// dbDeclNode returns the node to report errors about db at.
+// Databases defined implicitly by a migrations directory have no
+// call expression, so the package clause is used instead.
+func dbDeclNode(db *sqldb.Database) ast.Node {
+	if db.AST != nil {
+		return db.AST.Args[0]
+	}
+	if len(db.Pkg.Files) > 0 {
+		return db.Pkg.Files[0].AST().Name
+	}
+	return nil
+}
@@ -32,7 +32,8 @@ type Params struct {
 
 	Test option.Option[codegen.TestConfig]
 
-	ExecScriptMainPkg option.Option[paths.Pkg]
+	ExecScriptMainPkg    option.Option[paths.Pkg]
+	ExecScriptEntrypoint option.Option[string]
 }
 
 func Process(p Params) *config.Static {
@@ -44,6 +45,8 @@ func Process(p Params) *config.Static {
 		Test:              p.Test,
 		ExecScriptMainPkg: p.ExecScriptMainPkg,
 
+		ExecScriptEntrypoint: p.ExecScriptEntrypoint,
+
 		CompilerVersion: p.CompilerVersion,
 		AppRevision:     p.AppRevision,
 		AppUncommitted:  p.AppUncommitted,
@@ -1,6 +1,7 @@
 package maingen
 
 import (
+	"go/ast"
 	gotoken "go/token"
 
 	. "github.com/dave/jennifer/jen"
@@ -40,12 +41,21 @@ type GenParams struct {
 
 	// ExecScriptMainPkg is the main package to build for an ExecScript execution.
 	ExecScriptMainPkg option.Option[paths.Pkg]
+
+	// ExecScriptEntrypoint, if set, is the name of an exported function in
+	// ExecScriptMainPkg to run as the script, in which case ExecScriptMainPkg
+	// needn't be a main package. A main package calling it is generated,
+	// at the path given by ExecScriptEntrypointPkg.
+	ExecScriptEntrypoint option.Option[string]
 }
 
 func Gen(p GenParams) *config.Static {
 	if test, ok := p.Test.Get(); ok {
 		return genTestConfigs(p, test)
 	} else if execScript, ok := p.ExecScriptMainPkg.Get(); ok {
+		if fn, ok := p.ExecScriptEntrypoint.Get(); ok {
+			return genExecScriptEntrypoint(p, execScript, fn)
+		}
 		return genExecScriptMain(p, execScript)
 	} else {
 		return genMain(p)
@@ -123,3 +133,123 @@ func genExecScriptMain(p GenParams, mainPkgPath paths.Pkg) *config.Static {
 
 	return GenAppConfig(p, option.None[testParams]())
 }
+
+// ExecScriptEntrypointPkg returns the path of the main package generated
+// to run an ExecScript entrypoint function, within the main module.
+func ExecScriptEntrypointPkg(mainModule *pkginfo.Module) paths.Pkg {
+	return paths.Pkg(mainModule.Path).JoinSlash("encore_internal", "execscript")
+}
+
+// genExecScriptEntrypoint generates a main package that runs the exported
+// function fn in the package pkgPath as an ExecScript script.
+func genExecScriptEntrypoint(p GenParams, pkgPath paths.Pkg, fn string) *config.Static {
+	pkg, ok := p.Desc.Parse.PackageAt(pkgPath).Get()
+	if !ok {
+		p.Desc.Errs.Addf(gotoken.NoPos, "cannot find package %s in module %s",
+			pkgPath, p.MainModule.Path)
+		return nil
+	}
+	takesCtx, returnsErr, ok := entrypointSignature(p, pkg, fn)
+	if !ok {
+		return nil
+	}
+
+	mainPkgDir := p.MainModule.RootDir.Join("encore_internal", "execscript")
+	mainPkgPath := ExecScriptEntrypointPkg(p.MainModule)
+	file := p.Gen.InjectFile(mainPkgPath, "main", mainPkgDir, "main.go", "execscript")
+	f := file.Jen
+
+	// Import all services, auth handlers and global middleware so they get registered,
+	// as for regular scripts.
+	for _, svc := range p.Desc.Services {
+		svc.Framework.ForAll(func(svcDesc *apiframework.ServiceDesc) {
+			if rootPkg := svcDesc.RootPkg; rootPkg.ImportPath != pkgPath {
+				f.Anon(rootPkg.ImportPath.String())
+			}
+		})
+	}
+	if fw, ok := p.Desc.Framework.Get(); ok {
+		if ah, ok := fw.AuthHandler.Get(); ok {
+			f.Anon(ah.Decl.File.Pkg.ImportPath.String())
+		}
+		for _, mw := range fw.GlobalMiddleware {
+			f.Anon(mw.Decl.File.Pkg.ImportPath.String())
+		}
+	}
+
+	var args []Code
+	if takesCtx {
+		args = append(args, Id("ctx"))
+	}
+	call := Qual(pkgPath.String(), fn).Call(args...)
+	f.Func().Id("main").Params().BlockFunc(func(g *Group) {
+		if takesCtx {
+			// Cancel the context on interrupt, so the function can stop gracefully.
+			g.List(Id("ctx"), Id("cancel")).Op(":=").Qual("os/signal", "NotifyContext").Call(
+				Qual("context", "Background").Call(), Qual("os", "Interrupt"))
+			g.Defer().Id("cancel").Call()
+		}
+		if !returnsErr {
+			g.Add(call)
+			return
+		}
+		g.If(Err().Op(":=").Add(call), Err().Op("!=").Nil()).Block(
+			Qual("fmt", "Fprintln").Call(Qual("os", "Stderr"), Err()),
+			Qual("os", "Exit").Call(Lit(1)),
+		)
+	})
+
+	return GenAppConfig(p, option.None[testParams]())
+}
+
+// entrypointSignature validates that fn is an exported, non-generic function in pkg
+// with one of the supported entrypoint signatures:
+//
+//	func()
+//	func() error
+//	func(context.Context)
+//	func(context.Context) error
+//
+// It reports whether it takes a context and returns an error.
+func entrypointSignature(p GenParams, pkg *pkginfo.Package, fn string) (takesCtx, returnsErr, ok bool) {
+	var decl *ast.FuncDecl
+	var file *pkginfo.File
+	for _, f := range pkg.Files {
+		if f.TestFile {
+			continue
+		}
+		for _, d := range f.AST().Decls {
+			if fd, isFunc := d.(*ast.FuncDecl); isFunc && fd.Recv == nil && fd.Name.Name == fn {
+				decl, file = fd, f
+			}
+		}
+	}
+	if decl == nil || !ast.IsExported(fn) {
+		p.Desc.Errs.Addf(gotoken.NoPos, "cannot find the exported function %s in package %s", fn, pkg.ImportPath)
+		return false, false, false
+	}
+
+	invalid := func() (bool, bool, bool) {
+		p.Desc.Errs.Addf(decl.Pos(), "invalid signature for the script entrypoint %s.%s "+
+			"(must be func(), func() error, func(context.Context) or func(context.Context) error)", pkg.Name, fn)
+		return false, false, false
+	}
+	typ := decl.Type
+	if typ.TypeParams.NumFields() > 0 || typ.Params.NumFields() > 1 || typ.Results.NumFields() > 1 {
+		return invalid()
+	}
+	if typ.Params.NumFields() == 1 {
+		qn, ok := file.Names().ResolvePkgLevelRef(typ.Params.List[0].Type)
+		if !ok || qn.PkgPath != "context" || qn.Name != "Context" {
+			return invalid()
+		}
+		takesCtx = true
+	}
+	if typ.Results.NumFields() == 1 {
+		if ident, ok := typ.Results.List[0].Type.(*ast.Ident); !ok || ident.Name != "error" {
+			return invalid()
+		}
+		returnsErr = true
+	}
+	return takesCtx, returnsErr, true
+}
@@ -10,6 +10,8 @@ import (
 	"encr.dev/v2/codegen/apigen"
 	"encr.dev/v2/codegen/apigen/maingen"
 	"encr.dev/v2/codegen/internal/codegentest"
+	"encr.dev/pkg/option"
+	"encr.dev/pkg/paths"
 	"encr.dev/v2/internals/pkginfo"
 )
 
@@ -34,3 +36,20 @@ func TestCodegen(t *testing.T) {
 
 	codegentest.Run(t, fn)
 }
+
+func TestExecScriptEntrypoint(t *testing.T) {
+	maingen.GenerateForInternalPackageTests = true
+	fn := func(gen *codegen.Generator, desc *app.Desc) {
+		loader := pkginfo.New(gen.Context)
+		apigen.Process(apigen.Params{
+			Gen:                  gen,
+			Desc:                 desc,
+			MainModule:           loader.MainModule(),
+			RuntimeModule:        loader.RuntimeModule(),
+			ExecScriptMainPkg:    option.Some(paths.Pkg("example.com/script")),
+			ExecScriptEntrypoint: option.Some("Run"),
+		})
+	}
+
+	codegentest.RunDir(t, "testdata/execscript", fn)
+}
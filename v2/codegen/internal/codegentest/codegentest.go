@@ -34,13 +34,25 @@ type Case struct {
 var goldenUpdate = flag.Bool("golden-update", os.Getenv("GOLDEN_UPDATE") != "", "update golden files")
 
 func Run(t *testing.T, fn func(*codegen.Generator, *app.Desc)) {
+	RunDir(t, "testdata", fn)
+}
+
+// RunDir is like Run, but reads the test cases from dir instead of "testdata".
+//
+// Test cases with a "want:errors" file expect the errors matching the regexps
+// on its lines, in order, instead of generated code.
+func RunDir(t *testing.T, dir string, fn func(*codegen.Generator, *app.Desc)) {
 	flag.Parse()
 	c := qt.New(t)
-	tests := readTestCases(c, "testdata")
+	tests := readTestCases(c, dir)
 	for _, test := range tests {
 		c.Run(test.name, func(c *qt.C) {
 			tc := testutil.NewContext(c, false, test.input)
-			tc.FailTestOnErrors()
+			if len(test.wantErrs) > 0 {
+				defer tc.DeferExpectError(test.wantErrs...)
+			} else {
+				tc.FailTestOnErrors()
+			}
 
 			// Create a go.mod file in the main module directory if it doesn't already exist.
 			modPath := tc.MainModuleDir.Join("go.mod").ToIO()
@@ -63,6 +75,9 @@ func Run(t *testing.T, fn func(*codegen.Generator, *app.Desc)) {
 
 			// Run the codegen
 			fn(gen, appDesc)
+			if len(test.wantErrs) > 0 {
+				return
+			}
 
 			// Construct the map of generated code.
 			overlays := gen.Overlays()
@@ -115,6 +130,7 @@ type testCase struct {
 	name     string
 	input    *txtar.Archive
 	want     map[string]string
+	wantErrs []string
 }
 
 func parseTestCase(c *qt.C, file string) *testCase {
@@ -122,10 +138,19 @@ func parseTestCase(c *qt.C, file string) *testCase {
 	c.Assert(err, qt.IsNil)
 
 	want := make(map[string]string)
+	var wantErrs []string
 	for i := 0; i < len(ar.Files); i++ {
 		f := ar.Files[i]
 
-		if fn, ok := strings.CutPrefix(f.Name, "want:"); ok {
+		if f.Name == "want:errors" {
+			for _, line := range strings.Split(string(f.Data), "\n") {
+				if line = strings.TrimSpace(line); line != "" {
+					wantErrs = append(wantErrs, line)
+				}
+			}
+			ar.Files = slices.Delete(ar.Files, i, i+1)
+			i--
+		} else if fn, ok := strings.CutPrefix(f.Name, "want:"); ok {
 			want[fn] = string(f.Data)
 			ar.Files = slices.Delete(ar.Files, i, i+1)
 			i--
@@ -137,6 +162,7 @@ func parseTestCase(c *qt.C, file string) *testCase {
 		name:     strings.TrimSuffix(filepath.Base(file), ".txt"),
 		input:    ar,
 		want:     want,
+		wantErrs: wantErrs,
 	}
 }
 
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"go/token"
+	"io"
 	"os"
 	"os/exec"
 	"path"
@@ -44,6 +45,10 @@ type Config struct {
 	// Env are additional environment variables to set.
 	Env []string
 
+	// TempDir, if set, is the directory to create the temporary work dir in,
+	// instead of the system's temporary directory or the persistent work dir.
+	TempDir string
+
 	// MainPkg is the main package to build.
 	MainPkg paths.Pkg
 
@@ -55,6 +60,13 @@ type Config struct {
 
 	// StaticConfig is the static config to embed into the binary.
 	StaticConfig *config.Static
+
+	// ExtraFlags are additional flags to pass to "go build".
+	ExtraFlags []string
+
+	// Output, if non-nil, receives the output of "go build" as it runs.
+	// The output is still used to report compilation errors.
+	Output io.Writer
 }
 
 type Result struct {
@@ -278,6 +290,7 @@ func (b *builder) buildMain() {
 			args = append(args, "-gcflags", "all=-N -l")
 		}
 
+		args = append(args, b.cfg.ExtraFlags...)
 		args = append(args, b.cfg.MainPkg.String())
 
 		goroot := build.GOROOT
@@ -304,7 +317,15 @@ func (b *builder) buildMain() {
 		}
 		cmd.Env = append(os.Environ(), env...)
 		cmd.Dir = b.cfg.Ctx.MainModuleDir.ToIO()
-		out, err := cmd.CombinedOutput()
+
+		var buf bytes.Buffer
+		cmd.Stdout, cmd.Stderr = &buf, &buf
+		if w := b.cfg.Output; w != nil {
+			cmd.Stdout = io.MultiWriter(&buf, w)
+			cmd.Stderr = cmd.Stdout
+		}
+		err := cmd.Run()
+		out := buf.Bytes()
 		if err != nil {
 			if len(out) == 0 {
 				out = []byte(err.Error())
@@ -446,6 +467,15 @@ func isGo118Plus(f *modfile.File) bool {
 
 func (b *builder) prepareWorkDir() (workdir paths.FS, temporary bool) {
 	work, isTemp := (func() (string, bool) {
+		// If a temp dir is given, always use a temporary work dir within it.
+		if b.cfg.TempDir != "" {
+			tmp, err := os.MkdirTemp(b.cfg.TempDir, "encore-build")
+			if err != nil {
+				b.errs.Fatalf(token.NoPos, "unable to create workdir: %v", err)
+			}
+			return tmp, true
+		}
+
 		// If we have an appID, use a persistent work dir.
 		if appID, ok := b.cfg.Ctx.AppID.Get(); ok {
 			baseDir, err := os.UserCacheDir()
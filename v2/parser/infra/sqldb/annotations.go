@@ -0,0 +1,137 @@
+package sqldb
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// annotationPrefix is the prefix of migration annotations, which are SQL comments
+// in the leading comment block of a migration file, like so:
+//
+//	-- encore:environments local,test
+//	-- encore:author jane@example.com
+//	-- encore:requires-extension pgcrypto
+//	-- encore:destructive
+//	-- encore:pinned-last
+//	CREATE TABLE ...
+const annotationPrefix = "encore:"
+
+// migrationEnvironments are the valid environment names
+// for the "encore:environments" annotation.
+var migrationEnvironments = []string{"local", "development", "ephemeral", "test", "production"}
+
+// migrationAnnotations are the annotations parsed from a migration file.
+type migrationAnnotations struct {
+	// Environments are the environments the migration applies to,
+	// from the "encore:environments" annotation. Nil means all environments.
+	Environments []string
+
+	// Author is the author of the migration, from the "encore:author" annotation.
+	Author string
+
+	// RequiredExtensions are the Postgres extensions the migration requires,
+	// from "encore:requires-extension" annotations.
+	RequiredExtensions []string
+
+	// Destructive reports whether the migration is declared as destructive,
+	// with the "encore:destructive" annotation.
+	Destructive bool
+
+	// PinnedLast reports whether the migration is pinned to run last,
+	// with the "encore:pinned-last" annotation.
+	PinnedLast bool
+}
+
+// authorNameRe matches author names given without an email address.
+var authorNameRe = regexp.MustCompile(`^[\pL\pN][\pL\pN .'_-]*$`)
+
+// extensionNameRe matches Postgres extension names, like "pgcrypto" or "uuid-ossp".
+var extensionNameRe = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// parseAnnotations parses the annotations in the leading comment block of a migration file.
+func parseAnnotations(data []byte) (migrationAnnotations, error) {
+	var a migrationAnnotations
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(nil, len(data)+1)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		comment, ok := strings.CutPrefix(line, "--")
+		if !ok {
+			break // end of the leading comment block
+		}
+		comment = strings.TrimSpace(comment)
+		if !strings.HasPrefix(comment, annotationPrefix) {
+			continue
+		}
+
+		name, value, _ := strings.Cut(strings.TrimPrefix(comment, annotationPrefix), " ")
+		value = strings.TrimSpace(value)
+		switch name {
+		case "environments":
+			if a.Environments != nil {
+				return a, fmt.Errorf("duplicate encore:environments annotation")
+			}
+			a.Environments = []string{}
+			for _, env := range strings.Split(value, ",") {
+				env = strings.TrimSpace(env)
+				if !slices.Contains(migrationEnvironments, env) {
+					return a, fmt.Errorf("invalid environment %q in encore:environments annotation (must be one of %s)",
+						env, strings.Join(migrationEnvironments, ", "))
+				}
+				a.Environments = append(a.Environments, env)
+			}
+		case "author":
+			if a.Author != "" {
+				return a, fmt.Errorf("duplicate encore:author annotation")
+			} else if !validAuthor(value) {
+				return a, fmt.Errorf("invalid author %q in encore:author annotation (must be a name, an email address, or both as in \"Name <email>\")", value)
+			}
+			a.Author = value
+		case "requires-extension":
+			// The annotation may be repeated, and takes a comma-separated list.
+			for _, ext := range strings.Split(value, ",") {
+				ext = strings.TrimSpace(ext)
+				if !extensionNameRe.MatchString(ext) {
+					return a, fmt.Errorf("invalid extension name %q in encore:requires-extension annotation", ext)
+				} else if !slices.Contains(a.RequiredExtensions, ext) {
+					a.RequiredExtensions = append(a.RequiredExtensions, ext)
+				}
+			}
+		case "destructive":
+			if a.Destructive {
+				return a, fmt.Errorf("duplicate encore:destructive annotation")
+			} else if value != "" {
+				return a, fmt.Errorf("the encore:destructive annotation takes no value")
+			}
+			a.Destructive = true
+		case "pinned-last":
+			if a.PinnedLast {
+				return a, fmt.Errorf("duplicate encore:pinned-last annotation")
+			} else if value != "" {
+				return a, fmt.Errorf("the encore:pinned-last annotation takes no value")
+			}
+			a.PinnedLast = true
+		default:
+			return a, fmt.Errorf("unknown annotation %q", annotationPrefix+name)
+		}
+	}
+	return a, sc.Err()
+}
+
+// validAuthor reports whether author is a name, an email address,
+// or a name and email address like "Jane Doe <jane@example.com>".
+func validAuthor(author string) bool {
+	if strings.Contains(author, "@") {
+		_, err := mail.ParseAddress(author)
+		return err == nil
+	}
+	return authorNameRe.MatchString(author)
+}
@@ -0,0 +1,56 @@
+package sqldb
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// addedMigrations returns the migrations in new whose numbers
+// aren't in old, sorted by number.
+func addedMigrations(old, new []MigrationFile) []MigrationFile {
+	existing := make(map[uint64]bool, len(old))
+	for _, m := range old {
+		existing[m.Number] = true
+	}
+	var added []MigrationFile
+	for _, m := range new {
+		if !existing[m.Number] {
+			added = append(added, m)
+		}
+	}
+	slices.SortStableFunc(added, func(a, b MigrationFile) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+	return added
+}
+
+// Changelog renders a human-readable changelog of the migrations added between
+// the old and new states of a database's migrations, for example across two
+// releases, suitable for release notes.
+//
+// Migrations are identified by number; migrations in new whose numbers
+// aren't in old are listed with their descriptions, in number order.
+func Changelog(old, new []MigrationFile) string {
+	added := addedMigrations(old, new)
+
+	var b strings.Builder
+	switch len(added) {
+	case 0:
+		b.WriteString("No new migrations.\n")
+		return b.String()
+	case 1:
+		b.WriteString("1 new migration:\n")
+	default:
+		fmt.Fprintf(&b, "%d new migrations:\n", len(added))
+	}
+	for _, m := range added {
+		fmt.Fprintf(&b, "- %d: %s", m.Number, planDescription(m))
+		if m.Destructive {
+			b.WriteString(" (destructive)")
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
@@ -0,0 +1,96 @@
+package sqldb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"encr.dev/v2/parser/resource/resourceparser"
+)
+
+// ChecksumSuffix is the filename suffix of migration checksum sidecar files.
+//
+// A sidecar file is named after the migration it describes, like "1_foo.up.sql.sha256",
+// and contains the hex-encoded SHA-256 checksum of the migration. The output format
+// of sha256sum (the checksum followed by the filename) is also accepted.
+const ChecksumSuffix = ".sha256"
+
+// Checksum computes the checksum of the contents of a migration file,
+// as a hex-encoded SHA-256 hash.
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyChecksum verifies the migration m, located in the directory dir within fsys,
+// against its checksum sidecar file. It returns nil if there is no sidecar file.
+//
+// If m.Checksum is empty the checksum is computed from the migration file.
+func VerifyChecksum(fsys fs.FS, dir string, m MigrationFile) error {
+	sidecar := m.Filename + ChecksumSuffix
+	data, err := fs.ReadFile(fsys, path.Join(dir, sidecar))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return &MigrationError{Filename: m.Filename, Kind: MigrationErrRead,
+			Detail: fmt.Sprintf("could not read checksum file: %v", err), Err: err}
+	}
+
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return &MigrationError{Filename: m.Filename, Kind: MigrationErrChecksum,
+			Detail: fmt.Sprintf("checksum file %s is empty", sidecar)}
+	}
+	want := strings.ToLower(fields[0])
+
+	got := m.Checksum
+	if got == "" {
+		contents, err := fs.ReadFile(fsys, path.Join(dir, m.Filename))
+		if err != nil {
+			return &MigrationError{Filename: m.Filename, Kind: MigrationErrRead,
+				Detail: fmt.Sprintf("could not read migration: %v", err), Err: err}
+		}
+		got = Checksum(contents)
+	}
+
+	if got != want {
+		return &MigrationError{Filename: m.Filename, Kind: MigrationErrChecksum,
+			Detail: fmt.Sprintf("checksum mismatch (%s has %s, but the migration has %s)", sidecar, want, got)}
+	}
+	return nil
+}
+
+// identicalMigrations finds up migrations with identical contents, which usually
+// indicates a migration was duplicated rather than modified.
+// Empty migrations are not considered. Each returned pair is ordered by migration number.
+func identicalMigrations(migrations []MigrationFile) [][2]MigrationFile {
+	emptySum := Checksum(nil)
+	var pairs [][2]MigrationFile
+	first := make(map[string]MigrationFile)
+	for _, m := range migrations {
+		if m.Checksum == "" || m.Checksum == emptySum {
+			continue
+		}
+		if prev, ok := first[m.Checksum]; ok {
+			pairs = append(pairs, [2]MigrationFile{prev, m})
+		} else {
+			first[m.Checksum] = m
+		}
+	}
+	return pairs
+}
+
+// warnIdenticalMigrations logs a warning for each pair of migrations
+// with identical contents in the database.
+func warnIdenticalMigrations(p *resourceparser.Pass, db *Database) {
+	for _, pair := range identicalMigrations(db.Migrations) {
+		p.Log.Warn().
+			Str("db", db.Name).
+			Strs("migrations", []string{pair[0].Filename, pair[1].Filename}).
+			Msgf("db migrations %s and %s have identical contents", pair[0].Filename, pair[1].Filename)
+	}
+}
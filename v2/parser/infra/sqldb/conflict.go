@@ -0,0 +1,23 @@
+package sqldb
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+)
+
+// conflictMarkerRe matches the lines git inserts to mark a merge conflict.
+var conflictMarkerRe = regexp.MustCompile(`^(<{7}|\|{7}|={7}|>{7})(?:\s|$)`)
+
+// findConflictMarker reports the 1-based line number and the marker of the
+// first merge conflict marker in data, or zero if there are none.
+func findConflictMarker(data []byte) (line int, marker string) {
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(nil, len(data)+1)
+	for n := 1; sc.Scan(); n++ {
+		if m := conflictMarkerRe.FindSubmatch(sc.Bytes()); m != nil {
+			return n, string(m[1])
+		}
+	}
+	return 0, ""
+}
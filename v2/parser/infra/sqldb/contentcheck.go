@@ -0,0 +1,313 @@
+package sqldb
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/parser/resource/resourceparser"
+)
+
+// ContentCheck is an opt-in, advisory check of the contents of migrations.
+// Issues found by content checks are reported as warnings.
+type ContentCheck string
+
+const (
+	// CheckTransactions reports explicit transaction control statements,
+	// like BEGIN and COMMIT, in migrations that run in a transaction.
+	CheckTransactions ContentCheck = "transactions"
+
+	// CheckSchemas reports references to tables in other databases, like
+	// "otherdb.public.users", and to tables in hardcoded schemas, like "billing.invoices".
+	// Under Encore's model, each database is owned by a single service, so they often
+	// indicate a mistake.
+	CheckSchemas ContentCheck = "schemas"
+
+	// CheckDestructive reports migrations that contain destructive statements,
+	// like DROP TABLE, without being annotated with "-- encore:destructive",
+	// as well as annotated migrations without any destructive statements.
+	CheckDestructive ContentCheck = "destructive"
+
+	// CheckTracked reports migrations that aren't tracked by git, including those
+	// in subdirectories, which are easily forgotten when committing.
+	// It requires git, and is a no-op in checkMigrationContents since it
+	// concerns the directory rather than the contents of each migration.
+	CheckTracked ContentCheck = "tracked"
+
+	// CheckIdempotent reports CREATE statements without IF NOT EXISTS (or OR REPLACE),
+	// which fail when re-running migrations against a database in an inconsistent state.
+	// It's meant for iterative local development, so it's only reported in local builds.
+	CheckIdempotent ContentCheck = "idempotent"
+
+	// CheckGitIgnored reports migrations matching git's ignore rules, such as an
+	// ignored migration directory or "*.sql" pattern, since new migrations then
+	// aren't committed. Like CheckTracked, it requires git and is a no-op
+	// in checkMigrationContents.
+	CheckGitIgnored ContentCheck = "gitignored"
+
+	// CheckReversible reports tables created by up migrations that their down
+	// migrations don't drop, which suggests the down migration doesn't reverse
+	// the up migration. It's a heuristic, and concerns pairs of migrations,
+	// so it's run separately from the checks in checkMigrationContents.
+	CheckReversible ContentCheck = "reversible"
+
+	// CheckExecutable reports migrations with the executable bit set, which is
+	// usually a mistake, like a bad checkout or chmod. Like CheckTracked, it concerns
+	// the files rather than their contents, so it's a no-op in checkMigrationContents.
+	CheckExecutable ContentCheck = "executable"
+)
+
+// contentChecks are the supported content checks.
+var contentChecks = []ContentCheck{CheckTransactions, CheckSchemas, CheckDestructive, CheckTracked, CheckIdempotent, CheckGitIgnored, CheckReversible, CheckExecutable}
+
+// localBuildTag is the build tag set for local builds, as opposed to builds for deployment.
+const localBuildTag = "encore_local"
+
+// contentIssue describes an issue found by a content check.
+type contentIssue struct {
+	Filename string
+	Line     int // 1-based
+	Message  string
+}
+
+func (i contentIssue) String() string {
+	return fmt.Sprintf("db migration %s:%d: %s", i.Filename, i.Line, i.Message)
+}
+
+// txControlRe matches statements that control transactions.
+var txControlRe = regexp.MustCompile(`(?i)^(BEGIN|START\s+TRANSACTION|COMMIT|END|ROLLBACK|ABORT)\b`)
+
+// checkMigrationContents runs the given content checks on the migrations
+// in the directory dir within fsys.
+func checkMigrationContents(fsys fs.FS, dir string, migrations []MigrationFile, checks []ContentCheck) ([]contentIssue, error) {
+	var issues []contentIssue
+	for _, m := range migrations {
+		data, err := fs.ReadFile(fsys, path.Join(dir, m.Filename))
+		if err != nil {
+			return nil, err
+		}
+		sql := string(data)
+		for _, check := range checks {
+			switch check {
+			case CheckTransactions:
+				issues = append(issues, checkTransactionControl(m, sql)...)
+			case CheckSchemas:
+				issues = append(issues, checkSchemaReferences(m, sql)...)
+			case CheckDestructive:
+				issues = append(issues, checkDestructive(m, sql)...)
+			case CheckIdempotent:
+				issues = append(issues, checkIdempotent(m, sql)...)
+			}
+		}
+	}
+	return issues, nil
+}
+
+// checkTransactionControl reports the transaction control statements in the
+// migration m with contents sql, unless it's marked as not running in a transaction.
+func checkTransactionControl(m MigrationFile, sql string) []contentIssue {
+	if noTx, _ := m.Meta["no_transaction"].(bool); noTx {
+		return nil
+	}
+
+	var issues []contentIssue
+	for _, stmt := range statementPositions(sql) {
+		if kw := txControlRe.FindString(sql[stmt:]); kw != "" {
+			issues = append(issues, contentIssue{
+				Filename: m.Filename,
+				Line:     1 + strings.Count(sql[:stmt], "\n"),
+				Message: fmt.Sprintf("contains the transaction control statement %s, but the migration runs in a transaction"+
+					" (mark it with \"no_transaction: true\" in its front-matter if it manages its own transactions)",
+					strings.ToUpper(strings.Join(strings.Fields(kw), " "))),
+			})
+		}
+	}
+	return issues
+}
+
+// statementPositions returns the offsets in sql of the first non-comment,
+// non-whitespace character of each statement.
+func statementPositions(sql string) []int {
+	var positions []int
+	offset := 0
+	for _, stmt := range splitStatements(sql, SplitStatements) {
+		// The statements are consecutive substrings of sql.
+		start := offset + strings.Index(sql[offset:], stmt)
+		offset = start + len(stmt)
+		positions = append(positions, start+leadingCommentsLen(stmt))
+	}
+	return positions
+}
+
+// leadingCommentsLen returns the length of the whitespace and comments at the start of stmt.
+func leadingCommentsLen(stmt string) int {
+	i := 0
+	for i < len(stmt) {
+		switch {
+		case strings.HasPrefix(stmt[i:], "--"):
+			if end := strings.IndexByte(stmt[i:], '\n'); end >= 0 {
+				i += end + 1
+			} else {
+				i = len(stmt)
+			}
+		case strings.HasPrefix(stmt[i:], "/*"):
+			i = skipBlockComment(stmt, i)
+		case stmt[i] == ' ' || stmt[i] == '\t' || stmt[i] == '\n' || stmt[i] == '\r':
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// sqlIdent matches an unquoted or quoted SQL identifier.
+const sqlIdent = `(?:"[^"\n]+"|[A-Za-z_][A-Za-z0-9_$]*)`
+
+var (
+	// dbQualifiedRe matches three-part names, like "db.schema.table".
+	dbQualifiedRe = regexp.MustCompile(sqlIdent + `\s*\.\s*` + sqlIdent + `\s*\.\s*` + sqlIdent)
+
+	// schemaQualifiedRe matches schema-qualified names following
+	// keywords that are followed by a table name.
+	schemaQualifiedRe = regexp.MustCompile(`(?i)\b(?:FROM|JOIN|INTO|UPDATE|TABLE|REFERENCES)\s+(?:ONLY\s+)?(?:IF\s+(?:NOT\s+)?EXISTS\s+)?(` +
+		sqlIdent + `)\s*\.\s*` + sqlIdent)
+)
+
+// builtinSchemas are schemas that may be referenced explicitly
+// without it indicating a cross-database mistake.
+var builtinSchemas = []string{"public", "pg_catalog", "information_schema", "pg_temp"}
+
+// checkSchemaReferences reports references to tables in other databases
+// or in hardcoded schemas in the migration m with contents sql.
+func checkSchemaReferences(m MigrationFile, sql string) []contentIssue {
+	code := maskSQL(sql)
+	isIdentChar := func(c byte) bool {
+		return c == '_' || c == '$' || c == '.' || c == '"' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+	}
+	nextChar := func(i int) byte {
+		rest := strings.TrimLeft(code[i:], " \t\r\n")
+		if rest == "" {
+			return 0
+		}
+		return rest[0]
+	}
+	line := func(i int) int { return 1 + strings.Count(code[:i], "\n") }
+
+	var issues []contentIssue
+	reported := make(map[int]bool) // start offsets of reported references
+	for _, loc := range dbQualifiedRe.FindAllStringIndex(code, -1) {
+		start, end := loc[0], loc[1]
+		if (start > 0 && isIdentChar(code[start-1])) || nextChar(end) == '.' {
+			continue // part of a longer name
+		}
+		ref := strings.Join(strings.Fields(code[start:end]), "")
+		if db, _, _ := strings.Cut(ref, "."); slices.Contains(builtinSchemas, strings.ToLower(db)) {
+			continue // a schema-qualified column, like "public.users.id"
+		}
+		reported[start] = true
+		issues = append(issues, contentIssue{
+			Filename: m.Filename,
+			Line:     line(start),
+			Message:  fmt.Sprintf("references %s, which looks like a table in another database", ref),
+		})
+	}
+	for _, loc := range schemaQualifiedRe.FindAllStringSubmatchIndex(code, -1) {
+		start, end := loc[2], loc[1]
+		schema := code[loc[2]:loc[3]]
+		if reported[start] || nextChar(end) == '.' || nextChar(end) == ')' {
+			// Already reported, part of a longer name, or an expression like EXTRACT(... FROM t.col).
+			continue
+		} else if slices.Contains(builtinSchemas, strings.ToLower(strings.Trim(schema, `"`))) {
+			continue
+		}
+		ref := strings.Join(strings.Fields(code[start:end]), "")
+		issues = append(issues, contentIssue{
+			Filename: m.Filename,
+			Line:     line(start),
+			Message:  fmt.Sprintf("references %s in the hardcoded schema %s", ref, schema),
+		})
+	}
+	slices.SortStableFunc(issues, func(a, b contentIssue) int { return a.Line - b.Line })
+	return issues
+}
+
+// maskSQL returns sql with comments, string literals and dollar-quoted strings
+// replaced by spaces, keeping newlines so that offsets and line numbers are preserved.
+func maskSQL(sql string) string {
+	b := []byte(sql)
+	mask := func(from, to int) {
+		for j := from; j < to && j < len(b); j++ {
+			if b[j] != '\n' {
+				b[j] = ' '
+			}
+		}
+	}
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == '-' && strings.HasPrefix(sql[i:], "--"):
+			end := len(sql)
+			if n := strings.IndexByte(sql[i:], '\n'); n >= 0 {
+				end = i + n
+			}
+			mask(i, end)
+			i = end
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			end := skipBlockComment(sql, i)
+			mask(i, end)
+			i = end
+		case c == '\'':
+			backslash := i > 0 && (sql[i-1] == 'E' || sql[i-1] == 'e')
+			end := skipQuoted(sql, i, c, backslash)
+			mask(i, end)
+			i = end
+		case c == '"':
+			i = skipQuoted(sql, i, c, false) // quoted identifiers are kept
+		case c == '$':
+			end := i + 1
+			if tag, ok := dollarTag(sql[i:]); ok {
+				end = len(sql)
+				if n := strings.Index(sql[i+len(tag):], tag); n >= 0 {
+					end = i + len(tag) + n + len(tag)
+				}
+				mask(i, end)
+			}
+			i = end
+		default:
+			i++
+		}
+	}
+	return string(b)
+}
+
+// warnMigrationContents logs warnings for the issues found by
+// the database's content checks, if any are enabled.
+func warnMigrationContents(p *resourceparser.Pass, migrationDir paths.FS, db *Database) {
+	checks := db.ContentChecks
+	if !slices.Contains(p.Build.BuildTags, localBuildTag) {
+		// Production migrations needn't be idempotent.
+		checks = slices.DeleteFunc(slices.Clone(checks), func(c ContentCheck) bool { return c == CheckIdempotent })
+	}
+	if len(checks) == 0 {
+		return
+	}
+	fsys := migrationFS(migrationDir)
+	issues, err := checkMigrationContents(fsys, ".", db.Migrations, checks)
+	if err == nil && slices.Contains(checks, CheckReversible) {
+		var more []contentIssue
+		more, err = checkReversible(fsys, ".", db.migrationOptions(), db.Migrations)
+		issues = append(issues, more...)
+	}
+	if err != nil {
+		p.Log.Warn().Err(err).Str("db", db.Name).Msg("unable to check the contents of migrations")
+		return
+	}
+	for _, issue := range issues {
+		p.Log.Warn().Str("db", db.Name).Str("migration", issue.Filename).Int("line", issue.Line).Msg(issue.String())
+	}
+}
@@ -0,0 +1,35 @@
+package sqldb
+
+import (
+	"strings"
+
+	"encr.dev/v2/parser/resource/resourceparser"
+)
+
+// isNumericDescription reports whether desc consists only of digits,
+// possibly separated by underscores or dashes, like "2" or "2_3".
+func isNumericDescription(desc string) bool {
+	return strings.ContainsAny(desc, "0123456789") &&
+		strings.Trim(desc, "0123456789_-") == ""
+}
+
+// numericDescriptions returns the migrations whose descriptions are entirely numeric,
+// like "1_2.up.sql", which is usually a mistake such as a doubled migration number.
+func numericDescriptions(migrations []MigrationFile) []MigrationFile {
+	var numeric []MigrationFile
+	for _, m := range migrations {
+		if isNumericDescription(m.Description) {
+			numeric = append(numeric, m)
+		}
+	}
+	return numeric
+}
+
+// warnNumericDescriptions logs a warning for each of the database's
+// migrations whose description is entirely numeric.
+func warnNumericDescriptions(p *resourceparser.Pass, db *Database) {
+	for _, m := range numericDescriptions(db.Migrations) {
+		p.Log.Warn().Str("db", db.Name).Str("migration", m.Filename).
+			Msgf("db migration %s has the numeric description %q, which is likely a mistake such as a doubled migration number", m.Filename, m.Description)
+	}
+}
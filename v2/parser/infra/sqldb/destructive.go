@@ -0,0 +1,84 @@
+package sqldb
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// dropRe matches DROP statements and clauses, capturing the kind of object dropped
+// (or, for "ALTER TABLE ... DROP col", the column name), and TRUNCATE statements.
+var dropRe = regexp.MustCompile(`(?i)\b(?:DROP\s+(IF\s+EXISTS\s+)?(` + sqlIdent + `)|TRUNCATE\b)`)
+
+// destructiveDropKinds are the kinds of objects whose dropping loses data.
+var destructiveDropKinds = []string{"TABLE", "SCHEMA", "DATABASE", "COLUMN", "OWNED"}
+
+// nonDestructiveDropKinds are the keywords following DROP that don't lose data,
+// since the dropped objects hold no data of their own.
+var nonDestructiveDropKinds = []string{
+	"AGGREGATE", "CAST", "COLLATION", "CONSTRAINT", "DEFAULT", "DOMAIN", "EXPRESSION",
+	"EXTENSION", "FUNCTION", "IDENTITY", "INDEX", "MATERIALIZED", "NOT", "OPERATOR",
+	"POLICY", "PROCEDURE", "PUBLICATION", "ROLE", "RULE", "SEQUENCE", "SERVER",
+	"STATISTICS", "SUBSCRIPTION", "TRIGGER", "TYPE", "USER", "VIEW",
+}
+
+// destructiveStatements returns the destructive statements in sql, like "DROP TABLE",
+// along with their 1-based line numbers.
+func destructiveStatements(sql string) (stmts []string, lines []int) {
+	code := maskSQL(sql)
+	for _, loc := range dropRe.FindAllStringSubmatchIndex(code, -1) {
+		var stmt string
+		switch {
+		case loc[4] < 0:
+			stmt = "TRUNCATE"
+		case loc[2] >= 0:
+			// "DROP IF EXISTS" without an object kind only drops columns.
+			stmt = "DROP COLUMN"
+		default:
+			kind := strings.ToUpper(code[loc[4]:loc[5]])
+			if slices.Contains(destructiveDropKinds, kind) {
+				stmt = "DROP " + kind
+			} else if !slices.Contains(nonDestructiveDropKinds, kind) {
+				// The column keyword is optional in "ALTER TABLE ... DROP col".
+				stmt = "DROP COLUMN"
+			} else {
+				continue
+			}
+		}
+		stmts = append(stmts, stmt)
+		lines = append(lines, 1+strings.Count(code[:loc[0]], "\n"))
+	}
+	return stmts, lines
+}
+
+// checkDestructive reports mismatches between the destructive statements in the
+// migration m with contents sql and whether it's annotated as destructive.
+func checkDestructive(m MigrationFile, sql string) []contentIssue {
+	stmts, lines := destructiveStatements(sql)
+	if m.Destructive {
+		if len(stmts) > 0 {
+			return nil
+		}
+		line := 1
+		if idx := strings.Index(sql, annotationPrefix+"destructive"); idx >= 0 {
+			line += strings.Count(sql[:idx], "\n")
+		}
+		return []contentIssue{{
+			Filename: m.Filename,
+			Line:     line,
+			Message:  "is annotated with encore:destructive, but contains no destructive statements",
+		}}
+	}
+
+	var issues []contentIssue
+	for i, stmt := range stmts {
+		issues = append(issues, contentIssue{
+			Filename: m.Filename,
+			Line:     lines[i],
+			Message: fmt.Sprintf("contains the destructive statement %s, but isn't annotated as destructive"+
+				" (add a \"-- encore:destructive\" annotation to its leading comments)", stmt),
+		})
+	}
+	return issues
+}
@@ -0,0 +1,419 @@
+package sqldb
+
+import (
+	goerrors "errors"
+	"fmt"
+	"go/ast"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"encr.dev/pkg/errors"
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/internals/perr"
+	"encr.dev/v2/internals/pkginfo"
+	"encr.dev/v2/parser/apis/directive"
+)
+
+// dbDirective describes the database configuration declared
+// using an "encore:database" directive, like so:
+//
+//	//encore:database baseline=30 locktimeout=30s
+//	var db = sqldb.NewDatabase("name", sqldb.DatabaseConfig{...})
+//
+// For databases defined implicitly by a "migrations" directory
+// the directive is placed in the package doc comment instead.
+type dbDirective struct {
+	dir *directive.Directive // nil if there is no directive
+
+	// Name overrides the name of a database defined by a "migrations" directory,
+	// which defaults to the package name. It's declared as "name=billing".
+	Name string
+
+	// Baseline is the migration number up to which (inclusive)
+	// the migrations are considered already applied. Zero means no baseline.
+	Baseline uint64
+
+	// Naming is the naming convention used by the migration files,
+	// declared as "naming=direction-first" or "naming=direction-last" (the default).
+	Naming MigrationNaming
+
+	// LockTimeout is how long to wait for the migration lock,
+	// declared as a duration like "locktimeout=30s". Zero means no timeout.
+	LockTimeout time.Duration
+
+	// ForwardOnly reports whether the database is forward-only, meaning
+	// migrations are never rolled back. It's declared with the "forwardonly" option.
+	ForwardOnly bool
+
+	// StrictDowns, declared with the "strictdowns" option, makes the presence of
+	// down migrations an error in forward-only databases, instead of ignoring them.
+	StrictDowns bool
+
+	// Timestamps, declared with the "timestamps" option, makes migrations numbered
+	// by UTC timestamps in the form YYYYMMDDHHMMSS instead of a 1..N sequence.
+	Timestamps bool
+
+	// Recursive, declared with the "recursive" option, includes migrations in
+	// subdirectories of the migration directory, like "migrations/2024".
+	Recursive bool
+
+	// PostgresVersion is the major Postgres version the migrations target,
+	// declared as "pgversion=15". Zero means unspecified.
+	PostgresVersion int
+
+	// DependsOn are the names of the databases whose migrations must be applied
+	// before this database's migrations, declared as "dependson=users,billing".
+	DependsOn []string
+
+	// RequiredMigrations are migration descriptions that must be present
+	// among the database's migrations, declared as "require=create_audit_log".
+	RequiredMigrations []string
+
+	// MigrationPrefixes are filename prefixes to strip from migration filenames
+	// before parsing them, declared as "prefix=gen_,tool_".
+	MigrationPrefixes []string
+
+	// IgnorePatterns are glob patterns of files in the migration directory to skip
+	// when parsing migrations, declared as "ignore=*.disabled,.#*".
+	IgnorePatterns []string
+
+	// StatementSplitting controls how migrations are split into statements, declared as
+	// "split=statements" (the default), "split=semicolons" or "split=none".
+	StatementSplitting StatementSplitting
+
+	// Runner is the slash-separated path, relative to the package directory,
+	// of an external command to apply the migrations with instead of Encore's
+	// built-in migration runner, declared as "runner=tools/migrate".
+	Runner string
+
+	// MaxDescriptionLen is the maximum length of migration descriptions,
+	// declared as "maxdesclen=60". Zero means DefaultMaxDescriptionLen.
+	MaxDescriptionLen int
+
+	// Gaps is how gaps in the migration number sequence are reported, declared as
+	// "gaps=error", "gaps=warn" or "gaps=allow" (the default).
+	Gaps GapSeverity
+
+	// ContentChecks are the advisory checks to run on the contents
+	// of the migrations, declared as "check=transactions,schemas".
+	ContentChecks []ContentCheck
+
+	// Extensions are the Postgres extensions the database uses,
+	// declared as "extensions=pgcrypto,citext".
+	Extensions []string
+}
+
+// dbNameRe matches valid database names, the same as for sqldb.NewDatabase.
+var dbNameRe = regexp.MustCompile(`^[a-z]([_a-z0-9]*[a-z0-9])?$`)
+
+// migrationPrefixRe matches valid migration filename prefixes. They must start
+// with a letter so they can't be confused with migration numbers.
+var migrationPrefixRe = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+// field returns the directive field or option with the given key, for error reporting.
+func (d *dbDirective) field(key string) ast.Node {
+	for _, f := range d.dir.Fields {
+		if f.Key == key {
+			return f
+		}
+	}
+	for _, o := range d.dir.Options {
+		if o.Value == key {
+			return o
+		}
+	}
+	return d.dir
+}
+
+// parseDBDirective parses the "encore:database" directive in cg, if any.
+// It reports false if the directive is invalid.
+func parseDBDirective(errs *perr.List, cg *ast.CommentGroup) (d dbDirective, ok bool) {
+	dir, _, ok := directive.Parse(errs, cg)
+	if !ok {
+		return d, false
+	} else if dir == nil || dir.Name != "database" {
+		return d, true
+	}
+	d.dir = dir
+
+	ok = directive.Validate(errs, dir, directive.ValidateSpec{
+		AllowedOptions: []string{"forwardonly", "strictdowns", "timestamps", "recursive"},
+		AllowedFields:  []string{"baseline", "naming", "locktimeout", "pgversion", "dependson", "require", "prefix", "split", "runner", "maxdesclen", "gaps", "name", "ignore", "check", "extensions"},
+		ValidateField: func(errs *perr.List, f directive.Field) bool {
+			switch f.Key {
+			case "baseline":
+				num, err := strconv.ParseUint(f.Value, 10, 64)
+				if err != nil || num == 0 {
+					errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("baseline must be a positive migration number")))
+					return false
+				}
+				d.Baseline = num
+			case "naming":
+				switch f.Value {
+				case "direction-last":
+					d.Naming = NamingDirectionLast
+				case "direction-first":
+					d.Naming = NamingDirectionFirst
+				default:
+					errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("naming must be one of direction-last, direction-first")))
+					return false
+				}
+			case "locktimeout":
+				dur, err := time.ParseDuration(f.Value)
+				if err != nil || dur <= 0 {
+					errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("locktimeout must be a positive duration, like 30s")))
+					return false
+				}
+				d.LockTimeout = dur
+			case "pgversion":
+				v, err := strconv.Atoi(f.Value)
+				if err != nil || v < 9 {
+					errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("pgversion must be a Postgres major version, like 15")))
+					return false
+				}
+				d.PostgresVersion = v
+			case "dependson":
+				for _, name := range strings.Split(f.Value, ",") {
+					if name == "" {
+						errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("dependson must be a comma-separated list of database names")))
+						return false
+					}
+					d.DependsOn = append(d.DependsOn, name)
+				}
+			case "require":
+				for _, desc := range strings.Split(f.Value, ",") {
+					if desc == "" {
+						errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("require must be a comma-separated list of migration descriptions")))
+						return false
+					}
+					d.RequiredMigrations = append(d.RequiredMigrations, desc)
+				}
+			case "split":
+				switch f.Value {
+				case "statements":
+					d.StatementSplitting = SplitStatements
+				case "semicolons":
+					d.StatementSplitting = SplitSemicolons
+				case "none":
+					d.StatementSplitting = SplitNone
+				default:
+					errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("split must be one of statements, semicolons, none")))
+					return false
+				}
+			case "name":
+				if len(f.Value) > 63 || !dbNameRe.MatchString(f.Value) {
+					errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("name must be a snake_case database name of at most 63 characters, like billing_db")))
+					return false
+				}
+				d.Name = f.Value
+			case "gaps":
+				switch f.Value {
+				case "allow":
+					d.Gaps = GapsAllowed
+				case "warn":
+					d.Gaps = GapsWarn
+				case "error":
+					d.Gaps = GapsError
+				default:
+					errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("gaps must be one of allow, warn, error")))
+					return false
+				}
+			case "maxdesclen":
+				n, err := strconv.Atoi(f.Value)
+				if err != nil || n <= 0 {
+					errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("maxdesclen must be a positive number of characters")))
+					return false
+				}
+				d.MaxDescriptionLen = n
+			case "runner":
+				if !filepath.IsLocal(filepath.FromSlash(f.Value)) {
+					errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("runner must be a relative path within the package directory")))
+					return false
+				}
+				d.Runner = f.Value
+			case "prefix":
+				for _, prefix := range strings.Split(f.Value, ",") {
+					if !migrationPrefixRe.MatchString(prefix) {
+						errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("prefix must be a comma-separated list of prefixes made up of letters, digits, '_' and '-', starting with a letter")))
+						return false
+					}
+					d.MigrationPrefixes = append(d.MigrationPrefixes, prefix)
+				}
+			case "extensions":
+				for _, ext := range strings.Split(f.Value, ",") {
+					if !extensionNameRe.MatchString(ext) {
+						errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("extensions must be a comma-separated list of Postgres extension names, like pgcrypto")))
+						return false
+					}
+					d.Extensions = append(d.Extensions, ext)
+				}
+			case "check":
+				for _, check := range strings.Split(f.Value, ",") {
+					if !slices.Contains(contentChecks, ContentCheck(check)) {
+						errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("check must be a comma-separated list of content checks: transactions, schemas, destructive, tracked, idempotent, gitignored, reversible, executable")))
+						return false
+					}
+					d.ContentChecks = append(d.ContentChecks, ContentCheck(check))
+				}
+			case "ignore":
+				for _, pattern := range strings.Split(f.Value, ",") {
+					if _, err := path.Match(pattern, ""); err != nil || pattern == "" || strings.Contains(pattern, "/") {
+						errs.Add(errInvalidDatabaseDirective.AtGoNode(f, errors.AsError("ignore must be a comma-separated list of filename glob patterns, like *.disabled")))
+						return false
+					}
+					d.IgnorePatterns = append(d.IgnorePatterns, pattern)
+				}
+			}
+			return true
+		},
+	})
+	if !ok {
+		return d, false
+	}
+
+	d.ForwardOnly = dir.HasOption("forwardonly")
+	d.StrictDowns = dir.HasOption("strictdowns")
+	d.Timestamps = dir.HasOption("timestamps")
+	d.Recursive = dir.HasOption("recursive")
+	if d.StrictDowns && !d.ForwardOnly {
+		errs.Add(errInvalidDatabaseDirective.AtGoNode(d.field("strictdowns"), errors.AsError("strictdowns requires the forwardonly option")))
+		return d, false
+	} else if d.Timestamps && d.Gaps != GapsAllowed {
+		errs.Add(errInvalidDatabaseDirective.AtGoNode(d.field("gaps"), errors.AsError("gaps cannot be checked for migrations numbered by timestamp")))
+		return d, false
+	}
+	return d, true
+}
+
+// findDocDirective parses the "encore:database" directive from the doc comment
+// of the package-level variable the resource is assigned to, if any.
+func findDocDirective(errs *perr.List, stack []ast.Node) (d dbDirective, ok bool) {
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch node := stack[i].(type) {
+		case *ast.ValueSpec:
+			if node.Doc != nil {
+				return parseDBDirective(errs, node.Doc)
+			}
+		case *ast.GenDecl:
+			return parseDBDirective(errs, node.Doc)
+		}
+	}
+	return d, true
+}
+
+// findPkgDirective parses the "encore:database" directive from the
+// package doc comments of pkg, if any.
+func findPkgDirective(errs *perr.List, pkg *pkginfo.Package) (d dbDirective, ok bool) {
+	for _, file := range pkg.Files {
+		doc := file.AST().Doc
+		if doc == nil {
+			continue
+		}
+		fd, ok := parseDBDirective(errs, doc)
+		if !ok {
+			return d, false
+		} else if fd.dir == nil {
+			continue
+		} else if d.dir != nil {
+			errs.Add(errInvalidDatabaseDirective.AtGoNode(fd.dir, errors.AsError("duplicate directive")).AtGoNode(d.dir, errors.AsError("previously declared here")))
+			return d, false
+		}
+		d = fd
+	}
+	return d, true
+}
+
+// validateNewDatabase checks that the directive only uses fields
+// supported for databases defined with sqldb.NewDatabase.
+func (d *dbDirective) validateNewDatabase(errs *perr.List) bool {
+	if d.Name != "" {
+		errs.Add(errInvalidDatabaseDirective.AtGoNode(d.field("name"),
+			errors.AsError("name can only be set for databases defined by a migrations directory; sqldb.NewDatabase takes the name as its first argument")))
+		return false
+	}
+	return true
+}
+
+// validateBaseline checks that the declared baseline, if any,
+// refers to one of the given migrations.
+func (d *dbDirective) validateBaseline(errs *perr.List, migrations []MigrationFile) bool {
+	if err := checkBaseline(d.Baseline, migrations); err != nil {
+		errs.Add(errInvalidDatabaseDirective.AtGoNode(d.field("baseline"), errors.AsError(err.Error())))
+		return false
+	}
+	return true
+}
+
+// checkBaseline returns an error if the baseline, if any,
+// doesn't refer to one of the migrations.
+func checkBaseline(baseline uint64, migrations []MigrationFile) error {
+	found := slices.ContainsFunc(migrations, func(m MigrationFile) bool {
+		return m.Number == baseline
+	})
+	if baseline != 0 && !found {
+		return fmt.Errorf("baseline %d does not match any migration", baseline)
+	}
+	return nil
+}
+
+// validateRequired checks that the migrations include
+// all the required migrations, if any.
+func (d *dbDirective) validateRequired(errs *perr.List, migrations []MigrationFile) bool {
+	missing := missingRequired(d.RequiredMigrations, migrations)
+	for _, desc := range missing {
+		errs.Add(errMissingRequiredMigration(desc).AtGoNode(d.field("require"), errors.AsError("required here")))
+	}
+	return len(missing) == 0
+}
+
+// checkRequired returns an error if the migrations don't include
+// all the required migrations, if any.
+func checkRequired(required []string, migrations []MigrationFile) error {
+	var errs []error
+	for _, desc := range missingRequired(required, migrations) {
+		errs = append(errs, fmt.Errorf("requires a migration with the description %q, but none was found", desc))
+	}
+	return goerrors.Join(errs...)
+}
+
+// missingRequired returns the required migration descriptions
+// not found among the migrations.
+func missingRequired(required []string, migrations []MigrationFile) []string {
+	var missing []string
+	for _, desc := range required {
+		found := slices.ContainsFunc(migrations, func(m MigrationFile) bool {
+			return m.Description == desc
+		})
+		if !found {
+			missing = append(missing, desc)
+		}
+	}
+	return missing
+}
+
+// resolveRunner resolves the declared runner command, if any, relative to pkgDir
+// and checks that it's an existing file. It returns the path of the runner relative
+// to the main module, or "" if there is no runner.
+func (d *dbDirective) resolveRunner(errs *perr.List, pkgDir, mainModuleDir paths.FS) (runner paths.MainModuleRelSlash, ok bool) {
+	if d.Runner == "" {
+		return "", true
+	}
+
+	path := pkgDir.Join(filepath.FromSlash(d.Runner))
+	if fi, err := os.Stat(path.ToIO()); err != nil || fi.IsDir() {
+		errs.Add(errInvalidDatabaseDirective.AtGoNode(d.field("runner"), errors.AsError("runner command "+d.Runner+" not found")))
+		return "", false
+	}
+	rel, err := filepath.Rel(mainModuleDir.ToIO(), path.ToIO())
+	if err != nil || !filepath.IsLocal(rel) {
+		errs.Add(errInvalidDatabaseDirective.AtGoNode(d.field("runner"), errors.AsError("runner command must be located within the main module")))
+		return "", false
+	}
+	return paths.MainModuleRelSlash(filepath.ToSlash(rel)), true
+}
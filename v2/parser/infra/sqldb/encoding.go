@@ -0,0 +1,18 @@
+package sqldb
+
+import (
+	"unicode/utf8"
+)
+
+// invalidUTF8Offset reports the byte offset of the first invalid
+// UTF-8 sequence in data, or -1 if data is valid UTF-8.
+func invalidUTF8Offset(data []byte) int {
+	for i := 0; i < len(data); {
+		r, size := utf8.DecodeRune(data[i:])
+		if r == utf8.RuneError && size == 1 {
+			return i
+		}
+		i += size
+	}
+	return -1
+}
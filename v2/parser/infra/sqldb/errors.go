@@ -10,6 +10,12 @@ var (
 		"Multiple databases with the same name were found. Database names must be unique.",
 	)
 
+	ErrDuplicateMigrationDirs = errRange.New(
+		"Duplicate Migration Directories",
+		"Multiple packages define migrations for the same database. Each database must have a single migration directory; "+
+			"if the database was recently moved, remove the migration directory left behind.",
+	)
+
 	errRange = errors.Range(
 		"sqldb",
 		"For more information about how to use databases in Encore, see https://encore.dev/docs/primitives/databases",
@@ -59,4 +65,12 @@ var (
 		"Unknown sqldb database",
 		"No database named %q was found in the application. Ensure it is created somewhere using sqldb.NewDatabase to be able to reference it.",
 	)
+	errInvalidDatabaseDirective = errRange.New(
+		"Invalid encore:database directive",
+		"The encore:database directive is invalid.",
+	)
+	errMissingRequiredMigration = errRange.Newf(
+		"Missing required migration",
+		"The database requires a migration with the description %q, but none was found.",
+	)
 )
@@ -0,0 +1,20 @@
+package sqldb
+
+import (
+	"fmt"
+	"slices"
+)
+
+// checkRequiredExtensions returns an error if any of the migrations requires
+// an extension that's not among the extensions declared on the database.
+func checkRequiredExtensions(migrations []MigrationFile, declared []string) error {
+	for _, m := range migrations {
+		for _, ext := range m.RequiredExtensions {
+			if !slices.Contains(declared, ext) {
+				return &MigrationError{Filename: m.Filename, Kind: MigrationErrMissingExtension,
+					Detail: fmt.Sprintf("requires the extension %s, which is not declared on the database (declare it with //encore:database extensions=%s)", ext, ext)}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package sqldb
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// frontMatterFence delimits the front-matter block of a migration file,
+// which is a YAML document in SQL comments at the very top of the file, like so:
+//
+//	-- ---
+//	-- tags: [users]
+//	-- timeout: 5m
+//	-- no_transaction: true
+//	-- environments: [local, test]
+//	-- ---
+//	CREATE TABLE ...
+const frontMatterFence = "---"
+
+// parseFrontMatter parses the front-matter block of a migration file, if any.
+// It returns nil if the file has no front-matter.
+//
+// The supported keys are "tags" (a list of strings), "timeout" (a duration),
+// "no_transaction" (a boolean) and "environments" (a list of environment names).
+// Lists are stored as []string in the returned map.
+func parseFrontMatter(data []byte) (map[string]any, error) {
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(nil, len(data)+1)
+
+	// The opening fence must be the first non-empty line.
+	var opened bool
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		opened = frontMatterLine(line) == frontMatterFence
+		break
+	}
+	if !opened {
+		return nil, sc.Err()
+	}
+
+	var doc strings.Builder
+	var closed bool
+	for sc.Scan() {
+		line, ok := strings.CutPrefix(strings.TrimSpace(sc.Text()), "--")
+		if !ok {
+			break
+		} else if strings.TrimSpace(line) == frontMatterFence {
+			closed = true
+			break
+		}
+		// Strip the single space conventionally following "--",
+		// keeping the rest of the indentation intact for YAML.
+		doc.WriteString(strings.TrimPrefix(line, " "))
+		doc.WriteByte('\n')
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	} else if !closed {
+		return nil, fmt.Errorf("unterminated front-matter (missing closing \"-- %s\")", frontMatterFence)
+	}
+
+	var raw map[string]any
+	if err := yaml.Unmarshal([]byte(doc.String()), &raw); err != nil {
+		return nil, fmt.Errorf("invalid front-matter: %v", err)
+	}
+
+	meta := make(map[string]any, len(raw))
+	for key, val := range raw {
+		switch key {
+		case "tags":
+			tags, ok := stringList(val)
+			if !ok {
+				return nil, fmt.Errorf("front-matter tags must be a list of strings")
+			}
+			meta[key] = tags
+		case "timeout":
+			s, _ := val.(string)
+			if dur, err := time.ParseDuration(s); err != nil || dur <= 0 {
+				return nil, fmt.Errorf("front-matter timeout must be a positive duration, like 30s")
+			}
+			meta[key] = s
+		case "no_transaction":
+			b, ok := val.(bool)
+			if !ok {
+				return nil, fmt.Errorf("front-matter no_transaction must be true or false")
+			}
+			meta[key] = b
+		case "environments":
+			envs, ok := stringList(val)
+			if !ok {
+				return nil, fmt.Errorf("front-matter environments must be a list of strings")
+			}
+			for _, env := range envs {
+				if !slices.Contains(migrationEnvironments, env) {
+					return nil, fmt.Errorf("invalid environment %q in front-matter (must be one of %s)",
+						env, strings.Join(migrationEnvironments, ", "))
+				}
+			}
+			meta[key] = envs
+		default:
+			return nil, fmt.Errorf("unknown front-matter key %q", key)
+		}
+	}
+	return meta, nil
+}
+
+// frontMatterLine returns the contents of a front-matter comment line.
+func frontMatterLine(line string) string {
+	comment, ok := strings.CutPrefix(line, "--")
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(comment)
+}
+
+// stringList converts a parsed YAML list of strings to a []string.
+func stringList(val any) ([]string, bool) {
+	list, ok := val.([]any)
+	if !ok {
+		return nil, false
+	}
+	strs := make([]string, 0, len(list))
+	for _, v := range list {
+		s, ok := v.(string)
+		if !ok {
+			return nil, false
+		}
+		strs = append(strs, s)
+	}
+	return strs, true
+}
@@ -0,0 +1,68 @@
+package sqldb
+
+import (
+	"fmt"
+	"slices"
+
+	"encr.dev/v2/parser/resource/resourceparser"
+)
+
+// GapSeverity is how gaps in the migration number sequence are reported.
+type GapSeverity int
+
+const (
+	// GapsAllowed doesn't check for gaps. It's the default.
+	GapsAllowed GapSeverity = iota
+
+	// GapsWarn reports gaps as warnings, allowing the build to proceed.
+	GapsWarn
+
+	// GapsError reports gaps as errors.
+	GapsError
+)
+
+// largeGap is the number of skipped migration numbers above which a gap
+// is more likely caused by a mistyped migration number than by missing files.
+const largeGap = 100
+
+// migrationGaps returns an error for each gap in the number sequence of the
+// migrations, which must be sorted by number. The sequence may start at any number.
+// A migration pinned last is excluded, since it's renumbered as migrations are added.
+func migrationGaps(migrations []MigrationFile) []*MigrationError {
+	migrations = slices.DeleteFunc(slices.Clone(migrations), func(m MigrationFile) bool { return m.PinnedLast })
+	var gaps []*MigrationError
+	for i := 1; i < len(migrations); i++ {
+		prev, mig := migrations[i-1], migrations[i]
+		if mig.Number <= prev.Number+1 {
+			continue
+		}
+		detail := fmt.Sprintf("missing migration number %d (expected after %s)", prev.Number+1, prev.Filename)
+		if skipped := mig.Number - prev.Number - 1; skipped > largeGap {
+			detail = fmt.Sprintf("migration number %d skips %d numbers after %s; the number may be mistyped (expected %d)",
+				mig.Number, skipped, prev.Filename, prev.Number+1)
+		}
+		gaps = append(gaps, &MigrationError{Filename: mig.Filename, Kind: MigrationErrGap, Expected: prev.Number + 1, Actual: mig.Number,
+			Detail: detail})
+	}
+	return gaps
+}
+
+// checkMigrationGaps returns an error describing the first gap
+// in the number sequence of the migrations, if any.
+func checkMigrationGaps(migrations []MigrationFile) error {
+	if gaps := migrationGaps(migrations); len(gaps) > 0 {
+		return gaps[0]
+	}
+	return nil
+}
+
+// warnMigrationGaps logs a warning for each gap in the number sequence
+// of the database's migrations, if it's configured to warn about them.
+func warnMigrationGaps(p *resourceparser.Pass, db *Database) {
+	if db.Gaps != GapsWarn {
+		return
+	}
+	for _, gap := range migrationGaps(db.Migrations) {
+		p.Log.Warn().Str("db", db.Name).Uint64("missing", gap.Expected).Msg(gap.Error())
+	}
+}
@@ -0,0 +1,88 @@
+package sqldb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/parser/resource/resourceparser"
+)
+
+// gitIgnoredMigration describes a migration matched by git's ignore rules.
+type gitIgnoredMigration struct {
+	Filename string
+	Source   string // the ignore file and line with the pattern, like ".gitignore:3"
+	Pattern  string
+}
+
+// gitIgnoredMigrations returns the migrations in dir that match git's ignore rules,
+// whether or not they're tracked, in the order of migrations. This includes
+// migrations in an ignored directory.
+func gitIgnoredMigrations(dir string, migrations []MigrationFile) ([]gitIgnoredMigration, error) {
+	var stdin bytes.Buffer
+	for _, m := range migrations {
+		stdin.WriteString(m.Filename)
+		stdin.WriteByte(0)
+	}
+	if stdin.Len() == 0 {
+		return nil, nil
+	}
+
+	// nosemgrep: go.lang.security.audit.dangerous-exec-command.dangerous-exec-command
+	cmd := exec.Command("git", "check-ignore", "-z", "-v", "--no-index", "--stdin")
+	cmd.Dir = dir
+	cmd.Stdin = &stdin
+	out, err := cmd.Output()
+	if exitErr := (*exec.ExitError)(nil); errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return nil, nil // no path is ignored
+	} else if err != nil {
+		return nil, err
+	}
+
+	// The output is made up of NUL-terminated records of four fields:
+	// the source file, line number, pattern and path.
+	fields := bytes.Split(bytes.TrimSuffix(out, []byte{0}), []byte{0})
+	byName := make(map[string]gitIgnoredMigration)
+	for i := 0; i+3 < len(fields); i += 4 {
+		pattern := string(fields[i+2])
+		if strings.HasPrefix(pattern, "!") {
+			continue // the last matching pattern re-includes the path
+		}
+		name := string(fields[i+3])
+		byName[name] = gitIgnoredMigration{
+			Filename: name,
+			Source:   fmt.Sprintf("%s:%s", fields[i], fields[i+1]),
+			Pattern:  pattern,
+		}
+	}
+
+	var ignored []gitIgnoredMigration
+	for _, m := range migrations {
+		if im, ok := byName[m.Filename]; ok {
+			ignored = append(ignored, im)
+		}
+	}
+	return ignored, nil
+}
+
+// warnGitIgnoredMigrations logs a warning for each migration of the database that
+// matches git's ignore rules, if the "gitignored" check is enabled. Such migrations
+// exist locally, but new ones aren't committed, making other environments diverge.
+func warnGitIgnoredMigrations(p *resourceparser.Pass, migrationDir paths.FS, db *Database) {
+	if !slices.Contains(db.ContentChecks, CheckGitIgnored) {
+		return
+	}
+	ignored, err := gitIgnoredMigrations(migrationDir.ToIO(), db.Migrations)
+	if err != nil {
+		p.Log.Warn().Err(err).Str("db", db.Name).Msg("unable to check whether migrations are ignored by git")
+		return
+	}
+	for _, im := range ignored {
+		p.Log.Warn().Str("db", db.Name).Str("migration", im.Filename).
+			Msgf("db migration %s is ignored by git (pattern %q at %s), so it may never be committed", im.Filename, im.Pattern, im.Source)
+	}
+}
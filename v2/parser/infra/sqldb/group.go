@@ -0,0 +1,36 @@
+package sqldb
+
+import (
+	"strings"
+)
+
+// GroupByPrefix groups migrations by the prefix of their description,
+// which is the part of the description before the first occurrence of sep.
+// Migrations whose description doesn't contain sep are grouped by their whole description.
+//
+// The migrations within each group retain their relative order.
+func GroupByPrefix(migrations []MigrationFile, sep string) map[string][]MigrationFile {
+	groups := make(map[string][]MigrationFile)
+	for _, m := range migrations {
+		prefix, _, _ := strings.Cut(m.Description, sep)
+		groups[prefix] = append(groups[prefix], m)
+	}
+	return groups
+}
+
+// FindByDescription returns the migration with the given description.
+// Descriptions are compared case-insensitively, treating spaces and hyphens
+// as underscores, so "add users table" finds "1_add_users_table.up.sql".
+//
+// If several migrations have the description, the first one in the
+// slice is returned, which is the earliest one for sorted migrations.
+func FindByDescription(migrations []MigrationFile, desc string) (MigrationFile, bool) {
+	normalize := strings.NewReplacer(" ", "_", "-", "_").Replace
+	desc = normalize(desc)
+	for _, m := range migrations {
+		if strings.EqualFold(normalize(m.Description), desc) {
+			return m, true
+		}
+	}
+	return MigrationFile{}, false
+}
@@ -0,0 +1,59 @@
+package sqldb
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// createRe matches the start of CREATE statements for the kinds of objects that
+// can be created idempotently, capturing the modifiers, the kind of object,
+// the IF NOT EXISTS guard and the object name.
+var createRe = regexp.MustCompile(`(?i)^CREATE\s+((?:(?:OR\s+REPLACE|UNIQUE|GLOBAL|LOCAL|TEMP|TEMPORARY|UNLOGGED)\s+)*)` +
+	`(MATERIALIZED\s+VIEW|FOREIGN\s+TABLE|TABLE|INDEX|SCHEMA|SEQUENCE|EXTENSION|VIEW|FUNCTION|PROCEDURE|TRIGGER)\s+` +
+	`(?:CONCURRENTLY\s+)?(IF\s+NOT\s+EXISTS\s+)?(` + sqlIdent + `(?:\s*\.\s*` + sqlIdent + `)?)?`)
+
+// orReplaceKinds are the kinds of objects that are created idempotently
+// with CREATE OR REPLACE, rather than with IF NOT EXISTS.
+var orReplaceKinds = []string{"VIEW", "FUNCTION", "PROCEDURE", "TRIGGER"}
+
+// checkIdempotent reports the CREATE statements in the migration m with contents sql
+// that fail if the object already exists, because they lack IF NOT EXISTS or OR REPLACE.
+func checkIdempotent(m MigrationFile, sql string) []contentIssue {
+	var issues []contentIssue
+	for _, stmt := range statementPositions(sql) {
+		match := createRe.FindStringSubmatch(sql[stmt:])
+		if match == nil {
+			continue
+		}
+		modifiers := strings.ToUpper(strings.Join(strings.Fields(match[1]), " "))
+		kind := strings.ToUpper(strings.Join(strings.Fields(match[2]), " "))
+		name := strings.Join(strings.Fields(match[4]), "")
+
+		var guard string
+		switch {
+		case strings.Contains(modifiers, "OR REPLACE"), match[3] != "":
+			continue
+		case kind == "INDEX" && (name == "" || strings.EqualFold(name, "ON")):
+			// Unnamed indexes are given a fresh name each time, so they don't fail.
+			continue
+		case slices.Contains(orReplaceKinds, kind):
+			guard = "OR REPLACE"
+		default:
+			guard = "IF NOT EXISTS"
+		}
+
+		what := "CREATE " + kind
+		if name != "" {
+			what += " " + name
+		}
+		issues = append(issues, contentIssue{
+			Filename: m.Filename,
+			Line:     1 + strings.Count(sql[:stmt], "\n"),
+			Message: fmt.Sprintf("contains %s without %s, which fails if the migration is re-run"+
+				" against a database where the object already exists", what, guard),
+		})
+	}
+	return issues
+}
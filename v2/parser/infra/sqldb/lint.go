@@ -0,0 +1,34 @@
+package sqldb
+
+import (
+	"fmt"
+
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/internals/perr"
+	"encr.dev/v2/internals/pkginfo"
+)
+
+// LintPackage validates the migrations of the database implicitly defined
+// by pkg's "migrations" directory, reporting any problems to errs.
+//
+// It runs the same validation as MigrationParser, including its encore:database
+// directive, but without registering any resources or logging warnings.
+// Like the parser it ignores packages that don't look like services.
+// It reports whether the migrations are valid.
+func LintPackage(errs *perr.List, pkg *pkginfo.Package, mainModuleDir paths.FS) bool {
+	n := errs.Len()
+	parseImplicitDatabase(errs, pkg, mainModuleDir)
+	return errs.Len() == n
+}
+
+// LintMigrationDir validates the migrations in migrationDir for the database
+// with the given name, using the default database configuration.
+//
+// It's meant for checking a migration directory outside of a parsed
+// application, where there is no encore:database directive to consult.
+func LintMigrationDir(dbName string, migrationDir paths.FS) error {
+	if _, err := validateMigrations(&Database{Name: dbName}, migrationDir, nil); err != nil {
+		return fmt.Errorf("parsing db migrations in %s: %w", migrationDir, err)
+	}
+	return nil
+}
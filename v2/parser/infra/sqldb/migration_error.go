@@ -0,0 +1,112 @@
+package sqldb
+
+import (
+	"errors"
+)
+
+// MigrationErrorKind describes the kind of problem a MigrationError reports.
+type MigrationErrorKind string
+
+const (
+	// MigrationErrRead means the migrations could not be read.
+	MigrationErrRead MigrationErrorKind = "read"
+
+	// MigrationErrInvalidName means the migration filename doesn't follow the naming convention.
+	MigrationErrInvalidName MigrationErrorKind = "invalid-name"
+
+	// MigrationErrInvalidNumber means the migration number is not a positive integer.
+	MigrationErrInvalidNumber MigrationErrorKind = "invalid-number"
+
+	// MigrationErrDuplicate means multiple migrations share the same number.
+	MigrationErrDuplicate MigrationErrorKind = "duplicate"
+
+	// MigrationErrOutOfOrder means the migrations are not ordered by number.
+	MigrationErrOutOfOrder MigrationErrorKind = "out-of-order"
+
+	// MigrationErrDownNotAllowed means a down migration was found in a database that disallows them.
+	MigrationErrDownNotAllowed MigrationErrorKind = "down-not-allowed"
+
+	// MigrationErrChecksum means the migration doesn't match its checksum sidecar file.
+	MigrationErrChecksum MigrationErrorKind = "checksum"
+
+	// MigrationErrCaseCollision means multiple migration filenames differ only by case,
+	// which collide on case-insensitive filesystems.
+	MigrationErrCaseCollision MigrationErrorKind = "case-collision"
+
+	// MigrationErrInvalidAnnotation means the migration has an invalid annotation.
+	MigrationErrInvalidAnnotation MigrationErrorKind = "invalid-annotation"
+
+	// MigrationErrOrphanedDown means a down migration has no corresponding up migration.
+	MigrationErrOrphanedDown MigrationErrorKind = "orphaned-down"
+
+	// MigrationErrDescriptionTooLong means the migration description exceeds the maximum length.
+	MigrationErrDescriptionTooLong MigrationErrorKind = "description-too-long"
+
+	// MigrationErrGap means there's a gap in the migration number sequence.
+	MigrationErrGap MigrationErrorKind = "gap"
+
+	// MigrationErrMissingExtension means a migration requires
+	// an extension that's not declared on the database.
+	MigrationErrMissingExtension MigrationErrorKind = "missing-extension"
+
+	// MigrationErrConflictMarkers means the migration contains
+	// merge conflict markers, from an unresolved merge conflict.
+	MigrationErrConflictMarkers MigrationErrorKind = "conflict-markers"
+
+	// MigrationErrInvalidEncoding means the migration is not valid UTF-8.
+	MigrationErrInvalidEncoding MigrationErrorKind = "invalid-encoding"
+)
+
+// MigrationError describes a problem with a database's migrations.
+//
+// It's reported as the cause of migration parse errors, so tooling can
+// use errors.As to react to specific kinds of problems.
+type MigrationError struct {
+	// Database is the name of the database, if known.
+	Database string
+
+	// Filename is the filename of the migration, if the problem
+	// concerns a specific migration.
+	Filename string
+
+	Kind MigrationErrorKind
+
+	// Expected and Actual are the expected and actual migration numbers,
+	// for the kinds where that is applicable. Zero means not applicable.
+	Expected, Actual uint64
+
+	// Detail is a human-readable description of the problem.
+	Detail string
+
+	// Err is the underlying error, if any.
+	Err error
+}
+
+func (e *MigrationError) Error() string {
+	if e.Filename == "" {
+		return e.Detail
+	}
+	return "db migration " + e.Filename + ": " + e.Detail
+}
+
+func (e *MigrationError) Unwrap() error {
+	return e.Err
+}
+
+// reportMigrationError calls report with the MigrationError in err's chain, if any,
+// unless report is nil.
+func reportMigrationError(report func(*MigrationError), err error) {
+	var migErr *MigrationError
+	if report != nil && errors.As(err, &migErr) {
+		report(migErr)
+	}
+}
+
+// withDatabase records the database name on the MigrationError in err's chain, if any.
+func withDatabase(err error, dbName string) error {
+	var migErr *MigrationError
+	if errors.As(err, &migErr) && migErr.Database == "" {
+		migErr.Database = dbName
+	}
+	return err
+}
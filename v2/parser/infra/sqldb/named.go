@@ -57,5 +57,6 @@ func parseNamedSQLDB(d parseutil.ReferenceInfo) {
 		)
 	}
 
+	warnUppercaseName(d.Pass, dbName)
 	d.Pass.AddPathBind(d.File, d.Ident, resource.Path{{resource.SQLDatabase, dbName}})
 }
@@ -0,0 +1,94 @@
+package sqldb
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// MigrationNumbering is a convention for numbering migrations,
+// such as a 1..N sequence or timestamps.
+type MigrationNumbering interface {
+	// CheckNumber checks that num, the positive number of a migration
+	// as written in its filename (numStr), follows the convention.
+	// For conventions that number migrations by time,
+	// it returns the time the number represents.
+	CheckNumber(numStr string, num uint64) (time.Time, error)
+
+	// NextNumber returns the number to use for a new migration created at now,
+	// given the existing migrations.
+	NextNumber(existing []MigrationFile, now time.Time) uint64
+}
+
+var (
+	// SequentialNumbering numbers migrations by a 1..N sequence. It's the default.
+	SequentialNumbering MigrationNumbering = sequentialNumbering{}
+
+	// TimestampNumbering numbers migrations by UTC timestamps in the form YYYYMMDDHHMMSS.
+	TimestampNumbering MigrationNumbering = timestampNumbering{}
+)
+
+type sequentialNumbering struct{}
+
+func (sequentialNumbering) CheckNumber(numStr string, num uint64) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func (sequentialNumbering) NextNumber(existing []MigrationFile, now time.Time) uint64 {
+	return latestNumber(existing) + 1
+}
+
+type timestampNumbering struct{}
+
+// migrationTimestampLayout is the layout of migration numbers
+// for databases whose migrations are numbered by timestamp.
+const migrationTimestampLayout = "20060102150405"
+
+func (timestampNumbering) CheckNumber(numStr string, num uint64) (time.Time, error) {
+	ts, err := time.Parse(migrationTimestampLayout, numStr)
+	if err != nil || len(numStr) != len(migrationTimestampLayout) {
+		return time.Time{}, fmt.Errorf("invalid version number %s (must be a UTC timestamp in the form YYYYMMDDHHMMSS)", numStr)
+	}
+	return ts, nil
+}
+
+func (timestampNumbering) NextNumber(existing []MigrationFile, now time.Time) uint64 {
+	next := now.UTC().Truncate(time.Second)
+
+	// Keep the sequence increasing even if the clock is behind the latest migration.
+	if latest := latestNumber(existing); latest > 0 {
+		if ts, err := time.Parse(migrationTimestampLayout, strconv.FormatUint(latest, 10)); err == nil && !ts.Before(next) {
+			next = ts.Add(time.Second)
+		}
+	}
+	num, _ := strconv.ParseUint(next.Format(migrationTimestampLayout), 10, 64)
+	return num
+}
+
+// checkTimestampCollisions checks that no two of the migrations, ordered by number,
+// share the same timestamp, which happens when they're created in the same second,
+// such as on different branches. Unlike the duplicate check of checkMigrationOrder
+// it names both migrations, since either may need renumbering.
+func checkTimestampCollisions(migrations []MigrationFile) error {
+	for i := 1; i < len(migrations); i++ {
+		prev, mig := migrations[i-1], migrations[i]
+		if mig.Number == prev.Number {
+			return &MigrationError{Filename: mig.Filename, Kind: MigrationErrDuplicate, Actual: mig.Number,
+				Detail: fmt.Sprintf("duplicate migration timestamp %d, shared with %s (both created at %s UTC); "+
+					"renumber one of them so the timestamps are strictly increasing",
+					mig.Number, prev.Filename, mig.Timestamp.Format(time.DateTime))}
+		}
+	}
+	return nil
+}
+
+// latestNumber returns the highest number of the migrations, or zero if there are none.
+// It includes any migration pinned last: numbering a new migration below it would
+// cause the runner to skip the new migration once the pinned one has been applied.
+func latestNumber(migrations []MigrationFile) uint64 {
+	var latest uint64
+	for _, m := range migrations {
+		latest = max(latest, m.Number)
+	}
+	return latest
+}
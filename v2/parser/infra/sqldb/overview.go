@@ -0,0 +1,80 @@
+package sqldb
+
+import (
+	"cmp"
+	"slices"
+
+	"encr.dev/pkg/paths"
+)
+
+// MigrationOverview is an app-level overview of the databases
+// in an application and their migrations.
+type MigrationOverview struct {
+	Databases []*DatabaseOverview `json:"databases"`
+}
+
+// DatabaseOverview summarizes a single database in a MigrationOverview.
+type DatabaseOverview struct {
+	Name         string `json:"name"`
+	Package      string `json:"package"`
+	MigrationDir string `json:"migration_dir"`
+
+	// MigrationCount is the number of migrations.
+	MigrationCount int `json:"migration_count"`
+
+	// LatestMigration is the highest migration number, or zero if there are none.
+	LatestMigration uint64 `json:"latest_migration"`
+
+	// Baseline is the declared migration baseline, if any.
+	Baseline uint64 `json:"baseline,omitempty"`
+
+	// DependsOn are the names of the databases whose migrations
+	// must be applied before this database's migrations.
+	DependsOn []string `json:"depends_on,omitempty"`
+}
+
+// AppMigrationOverview aggregates the given databases into an app-level overview,
+// ordered by database name.
+func AppMigrationOverview(dbs []*Database) *MigrationOverview {
+	overview := &MigrationOverview{Databases: make([]*DatabaseOverview, 0, len(dbs))}
+	for _, db := range dbs {
+		o := &DatabaseOverview{
+			Name:           db.Name,
+			MigrationDir:   string(db.MigrationDir),
+			MigrationCount: len(db.Migrations),
+			Baseline:       db.Baseline,
+			DependsOn:      slices.Clone(db.DependsOn),
+		}
+		if db.Pkg != nil {
+			o.Package = db.Pkg.ImportPath.String()
+		}
+		for _, m := range db.Migrations {
+			o.LatestMigration = max(o.LatestMigration, m.Number)
+		}
+		overview.Databases = append(overview.Databases, o)
+	}
+	slices.SortFunc(overview.Databases, func(a, b *DatabaseOverview) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return overview
+}
+
+// MigrationDirs returns the distinct migration directories of the given databases,
+// defined in the main module directory mainModuleDir, in the order they're first
+// encountered. It's useful for watching the directories for changes, or for
+// invalidating cached builds when they change.
+//
+// Databases without a package are skipped, since they aren't parsed from a migration directory.
+func MigrationDirs(mainModuleDir paths.FS, dbs []*Database) []paths.FS {
+	var dirs []paths.FS
+	for _, db := range dbs {
+		if db.Pkg == nil {
+			continue
+		}
+		dir := paths.FS(db.MigrationDir.ToIO(mainModuleDir))
+		if !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
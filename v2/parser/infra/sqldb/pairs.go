@@ -0,0 +1,60 @@
+package sqldb
+
+import (
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/parser/resource/resourceparser"
+)
+
+// mismatchedDowns returns the filenames of the down migrations in the directory dir
+// within fsys whose descriptions differ from those of the up migrations with the
+// same numbers, as pairs of the up and down filenames. It's usually caused by a typo
+// when naming or renaming one of the files, like "1_add_users.up.sql" paired
+// with "1_add_user.down.sql".
+func mismatchedDowns(fsys fs.FS, dir string, opts migrationOptions, ups []MigrationFile) ([][2]string, error) {
+	if opts.IgnoreDowns {
+		return nil, nil
+	}
+	files, err := migrationFileNames(fsys, dir, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	upsByNumber := make(map[uint64]MigrationFile, len(ups))
+	for _, m := range ups {
+		upsByNumber[m.Number] = m
+	}
+	var mismatched [][2]string
+	for _, name := range files {
+		base := path.Base(name)
+		if ignoreMigrationFile(base, opts.Ignore) || filepath.Ext(strings.ToLower(base)) != ".sql" {
+			continue
+		}
+		down, direction, err := parseMigrationName(base, opts)
+		if err != nil || direction != "down" {
+			continue // invalid names are reported when parsing the migrations
+		}
+		if up, ok := upsByNumber[down.Number]; ok && up.Description != down.Description {
+			mismatched = append(mismatched, [2]string{up.Filename, name})
+		}
+	}
+	return mismatched, nil
+}
+
+// warnMismatchedDowns logs a warning for each of the database's down migrations
+// whose description differs from that of its up migration.
+func warnMismatchedDowns(p *resourceparser.Pass, migrationDir paths.FS, db *Database) {
+	mismatched, err := mismatchedDowns(migrationFS(migrationDir), ".", db.migrationOptions(), db.Migrations)
+	if err != nil {
+		p.Log.Warn().Err(err).Str("db", db.Name).Msg("unable to check the descriptions of down migrations")
+		return
+	}
+	for _, pair := range mismatched {
+		p.Log.Warn().Str("db", db.Name).Str("migration", pair[1]).
+			Msgf("db migration %s has a different description than its up migration %s; check the filenames for typos", pair[1], pair[0])
+	}
+}
@@ -0,0 +1,43 @@
+package sqldb
+
+import (
+	"io/fs"
+	"path"
+	"slices"
+
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/parser/resource/resourceparser"
+)
+
+// executableMigrations returns the filenames of the migrations in the directory
+// dir within fsys that have any of the executable permission bits set.
+func executableMigrations(fsys fs.FS, dir string, migrations []MigrationFile) ([]string, error) {
+	var executable []string
+	for _, m := range migrations {
+		info, err := fs.Stat(fsys, path.Join(dir, m.Filename))
+		if err != nil {
+			return nil, err
+		}
+		if info.Mode().Perm()&0o111 != 0 {
+			executable = append(executable, m.Filename)
+		}
+	}
+	return executable, nil
+}
+
+// warnExecutableMigrations logs a warning for each of the database's migrations
+// that's executable, if the "executable" check is enabled.
+func warnExecutableMigrations(p *resourceparser.Pass, migrationDir paths.FS, db *Database) {
+	if !slices.Contains(db.ContentChecks, CheckExecutable) {
+		return
+	}
+	executable, err := executableMigrations(migrationFS(migrationDir), ".", db.Migrations)
+	if err != nil {
+		p.Log.Warn().Err(err).Str("db", db.Name).Msg("unable to check the permissions of migrations")
+		return
+	}
+	for _, filename := range executable {
+		p.Log.Warn().Str("db", db.Name).Str("migration", filename).
+			Msgf("db migration %s is executable; migrations should not have the executable bit set (fix it with chmod -x)", filename)
+	}
+}
@@ -0,0 +1,79 @@
+package sqldb
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/parser/resource/resourceparser"
+)
+
+// pgFeature is an SQL feature that requires a minimum Postgres version.
+type pgFeature struct {
+	Name       string
+	MinVersion int
+	re         *regexp.Regexp
+}
+
+// pgFeatures is a curated list of SQL features that require
+// a more recent Postgres version than the oldest supported one.
+// It's not exhaustive, but catches common mismatches.
+var pgFeatures = []pgFeature{
+	{"identity columns (GENERATED AS IDENTITY)", 10, regexp.MustCompile(`(?i)\bGENERATED\s+(ALWAYS|BY\s+DEFAULT)\s+AS\s+IDENTITY\b`)},
+	{"procedures (CREATE PROCEDURE)", 11, regexp.MustCompile(`(?i)\bCREATE\s+(OR\s+REPLACE\s+)?PROCEDURE\b`)},
+	{"covering indexes (INCLUDE)", 11, regexp.MustCompile(`(?i)\bCREATE\s+(UNIQUE\s+)?INDEX\b[^;]*\bINCLUDE\s*\(`)},
+	{"generated columns (GENERATED ALWAYS AS ... STORED)", 12, regexp.MustCompile(`(?i)\bGENERATED\s+ALWAYS\s+AS\s*\([^;]*\)\s*STORED\b`)},
+	{"built-in gen_random_uuid()", 13, regexp.MustCompile(`(?i)\bgen_random_uuid\s*\(`)},
+	{"CREATE OR REPLACE TRIGGER", 14, regexp.MustCompile(`(?i)\bCREATE\s+OR\s+REPLACE\s+TRIGGER\b`)},
+	{"MERGE statements", 15, regexp.MustCompile(`(?i)\bMERGE\s+INTO\b`)},
+	{"NULLS NOT DISTINCT constraints", 15, regexp.MustCompile(`(?i)\bNULLS\s+NOT\s+DISTINCT\b`)},
+	{"JSON_TABLE", 17, regexp.MustCompile(`(?i)\bJSON_TABLE\s*\(`)},
+}
+
+// pgVersionIssue describes a migration using a feature
+// that's not supported by the target Postgres version.
+type pgVersionIssue struct {
+	Filename string
+	Feature  pgFeature
+}
+
+func (i pgVersionIssue) String() string {
+	return fmt.Sprintf("db migration %s: uses %s, which requires Postgres %d or later",
+		i.Filename, i.Feature.Name, i.Feature.MinVersion)
+}
+
+// checkPostgresVersion checks the migrations in the directory dir within fsys
+// for features not supported by the given Postgres major version.
+func checkPostgresVersion(fsys fs.FS, dir string, migrations []MigrationFile, version int) ([]pgVersionIssue, error) {
+	var issues []pgVersionIssue
+	for _, m := range migrations {
+		data, err := fs.ReadFile(fsys, path.Join(dir, m.Filename))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range pgFeatures {
+			if f.MinVersion > version && f.re.Match(data) {
+				issues = append(issues, pgVersionIssue{Filename: m.Filename, Feature: f})
+			}
+		}
+	}
+	return issues, nil
+}
+
+// warnPostgresVersion logs warnings for migrations using features
+// not supported by the database's declared Postgres version, if any.
+func warnPostgresVersion(p *resourceparser.Pass, migrationDir paths.FS, db *Database) {
+	if db.PostgresVersion == 0 {
+		return
+	}
+	issues, err := checkPostgresVersion(migrationFS(migrationDir), ".", db.Migrations, db.PostgresVersion)
+	if err != nil {
+		p.Log.Warn().Err(err).Str("db", db.Name).Msg("unable to check migrations against the declared postgres version")
+		return
+	}
+	for _, issue := range issues {
+		p.Log.Warn().Str("db", db.Name).Int("pgversion", db.PostgresVersion).Msg(issue.String())
+	}
+}
@@ -0,0 +1,69 @@
+package sqldb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RenderPlan renders the database's migrations as a human-readable,
+// ordered list of migration numbers and descriptions, for CLI output.
+//
+// Migrations are annotated with notes where relevant, such as migrations
+// covered by the baseline, migrations pinned last, migrations restricted to certain environments,
+// and issues detected during parsing (marked with "!").
+func (d *Database) RenderPlan() string {
+	var b strings.Builder
+	switch n := len(d.Migrations); n {
+	case 0:
+		fmt.Fprintf(&b, "Database %s: no migrations\n", d.Name)
+		return b.String()
+	case 1:
+		fmt.Fprintf(&b, "Database %s: 1 migration\n", d.Name)
+	default:
+		fmt.Fprintf(&b, "Database %s: %d migrations\n", d.Name, n)
+	}
+
+	issues := make(map[uint64][]string)
+	for _, pair := range identicalMigrations(d.Migrations) {
+		issues[pair[1].Number] = append(issues[pair[1].Number], "identical to "+pair[0].Filename)
+	}
+
+	numWidth, descWidth := 0, 0
+	for _, m := range d.Migrations {
+		numWidth = max(numWidth, len(strconv.FormatUint(m.Number, 10)))
+		descWidth = max(descWidth, len(planDescription(m)))
+	}
+
+	for _, m := range d.Migrations {
+		var notes []string
+		if d.Baseline > 0 && m.Number <= d.Baseline {
+			notes = append(notes, "baseline")
+		}
+		if m.PinnedLast {
+			notes = append(notes, "pinned last")
+		}
+		if m.Environments != nil {
+			notes = append(notes, "environments: "+strings.Join(m.Environments, ", "))
+		}
+		for _, issue := range issues[m.Number] {
+			notes = append(notes, "! "+issue)
+		}
+
+		line := fmt.Sprintf("  %*d  %-*s", numWidth, m.Number, descWidth, planDescription(m))
+		if len(notes) > 0 {
+			line += "  [" + strings.Join(notes, "; ") + "]"
+		}
+		b.WriteString(strings.TrimRight(line, " "))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// planDescription returns the description of m to use in a rendered plan.
+func planDescription(m MigrationFile) string {
+	if m.Description == "" {
+		return "(no description)"
+	}
+	return m.Description
+}
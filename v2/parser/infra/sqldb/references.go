@@ -0,0 +1,50 @@
+package sqldb
+
+import (
+	"slices"
+
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/parser/resource"
+	"encr.dev/v2/parser/resource/usage"
+)
+
+// ResourceGraph is the part of the parse result needed to find database references.
+// It's implemented by *parser.Result.
+type ResourceGraph interface {
+	Resources() []resource.Resource
+	Binds(res resource.Resource) []resource.Bind
+	Usages(res resource.Resource) []usage.Usage
+}
+
+// DatabaseReferences reports, for each database in the parse result,
+// the packages that reference it, ordered by import path.
+//
+// A package references a database if it binds it, including with sqldb.Named,
+// or uses one of its binds. The package defining the database is included.
+func DatabaseReferences(result ResourceGraph) map[*Database][]paths.Pkg {
+	refs := make(map[*Database][]paths.Pkg)
+	for _, r := range result.Resources() {
+		db, ok := r.(*Database)
+		if !ok {
+			continue
+		}
+
+		var pkgs []paths.Pkg
+		if db.Pkg != nil {
+			pkgs = append(pkgs, db.Pkg.ImportPath)
+		}
+		for _, b := range result.Binds(db) {
+			if pkg := b.Package(); pkg != nil {
+				pkgs = append(pkgs, pkg.ImportPath)
+			}
+		}
+		for _, u := range result.Usages(db) {
+			if f := u.DeclaredIn(); f != nil && f.Pkg != nil {
+				pkgs = append(pkgs, f.Pkg.ImportPath)
+			}
+		}
+		slices.Sort(pkgs)
+		refs[db] = slices.Compact(pkgs)
+	}
+	return refs
+}
@@ -0,0 +1,173 @@
+package sqldb
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"encr.dev/pkg/paths"
+)
+
+// MigrationRename is a proposed rename of a migration file to its canonical name.
+// The filenames are slash-separated and relative to the migration directory.
+type MigrationRename struct {
+	Old, New string
+}
+
+// ProposeMigrationRenames proposes renames of the migration files in migrationDir
+// to their canonical names, using the default migration options, without performing
+// them. It returns nil if all the names are already canonical.
+//
+// The canonical name of a migration is the one that the naming convention expects:
+// the direction and extension are lowercase, as in "1_foo.up.sql" rather than
+// "1_foo.UP.SQL", and a missing direction is taken to be "up". If any migration
+// number is zero-padded, all numbers are padded to the same width, like "0001".
+// Files whose names can't be parsed even after renaming are left alone, since they
+// aren't recognizably migrations.
+//
+// It returns an error if a rename would overwrite another file.
+// The renames can be performed with ApplyMigrationRenames.
+func ProposeMigrationRenames(migrationDir paths.FS) ([]MigrationRename, error) {
+	return canonicalMigrationNames(migrationFS(migrationDir), ".", migrationOptions{})
+}
+
+// ApplyMigrationRenames renames the migration files in migrationDir as proposed by
+// ProposeMigrationRenames. It stops at the first rename that fails, and refuses
+// to overwrite existing files.
+func ApplyMigrationRenames(migrationDir paths.FS, renames []MigrationRename) error {
+	for _, r := range renames {
+		oldPath := migrationDir.Join(r.Old).ToIO()
+		newPath := migrationDir.Join(r.New).ToIO()
+		if fi, err := os.Lstat(newPath); err == nil {
+			// Renames that only change case find the file itself on
+			// case-insensitive filesystems.
+			if old, err := os.Lstat(oldPath); err != nil || !os.SameFile(fi, old) {
+				return fmt.Errorf("rename %s to %s: %s already exists", r.Old, r.New, r.New)
+			}
+		}
+		if err := os.Rename(oldPath, newPath); err != nil {
+			return fmt.Errorf("rename %s to %s: %w", r.Old, r.New, err)
+		}
+	}
+	return nil
+}
+
+// canonicalMigrationNames proposes renames of the migration files in the directory
+// dir within fsys to their canonical names, as described by ProposeMigrationRenames.
+func canonicalMigrationNames(fsys fs.FS, dir string, opts migrationOptions) ([]MigrationRename, error) {
+	files, err := migrationFileNames(fsys, dir, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	type candidate struct {
+		name, prefix, numStr, desc, direction string
+		num                                   uint64
+	}
+	var (
+		candidates []candidate
+		width      int  // the width of the widest migration number
+		padded     bool // whether any migration number is zero-padded
+	)
+	for _, name := range files {
+		base := path.Base(name)
+		if ignoreMigrationFile(base, opts.Ignore) || filepath.Ext(strings.ToLower(base)) != ".sql" {
+			continue
+		}
+		fixed := opts.Naming.canonicalCase(base)
+		m, direction, err := parseMigrationName(fixed, opts)
+		if err != nil {
+			continue // not recognizably a migration
+		}
+		prefix := ""
+		for _, p := range opts.Prefixes {
+			if strings.HasPrefix(fixed, p) {
+				prefix = p
+				break
+			}
+		}
+		numStr, _, _, _ := opts.Naming.match(strings.TrimPrefix(fixed, prefix))
+		candidates = append(candidates, candidate{
+			name: name, prefix: prefix, numStr: numStr, num: m.Number,
+			desc: m.Description, direction: direction,
+		})
+		width = max(width, len(numStr))
+		padded = padded || strings.HasPrefix(numStr, "0")
+	}
+
+	existing := make(map[string]bool, len(files))
+	for _, name := range files {
+		existing[name] = true
+	}
+	var renames []MigrationRename
+	targets := make(map[string]string) // new name -> old name
+	for _, c := range candidates {
+		numStr := c.numStr
+		if padded {
+			numStr = fmt.Sprintf("%0*d", width, c.num)
+		}
+		base := c.prefix + opts.Naming.filename(numStr, c.desc, c.direction)
+		newName := path.Join(path.Dir(c.name), base)
+		if newName == c.name {
+			continue
+		}
+		if prev, ok := targets[newName]; ok {
+			return nil, fmt.Errorf("cannot rename both %s and %s to %s", prev, c.name, newName)
+		} else if existing[newName] {
+			return nil, fmt.Errorf("cannot rename %s to %s: %s already exists", c.name, newName, newName)
+		}
+		targets[newName] = c.name
+		renames = append(renames, MigrationRename{Old: c.name, New: newName})
+	}
+	return renames, nil
+}
+
+// canonicalCase returns the filename with its extension and direction in lowercase,
+// as the naming convention expects. Under NamingDirectionLast, a filename without
+// a direction, like "1_foo.sql", is given the up direction.
+func (c MigrationNaming) canonicalCase(filename string) string {
+	stem, ext := filename, filepath.Ext(filename)
+	stem = strings.TrimSuffix(stem, ext)
+	ext = strings.ToLower(ext)
+
+	if c == NamingDirectionFirst {
+		parts := strings.SplitN(stem, "_", 3)
+		if len(parts) >= 2 {
+			if dir := strings.ToLower(parts[1]); dir == "up" || dir == "down" {
+				parts[1] = dir
+			}
+		}
+		return strings.Join(parts, "_") + ext
+	}
+
+	base, dir, ok := cutLast(stem, ".")
+	if ok {
+		if dir = strings.ToLower(dir); dir == "up" || dir == "down" {
+			return base + "." + dir + ext
+		}
+	}
+	return stem + ".up" + ext
+}
+
+// filename returns the filename of a migration under the naming convention.
+// The description may be empty.
+func (c MigrationNaming) filename(numStr, desc, direction string) string {
+	if desc != "" {
+		desc = "_" + desc
+	}
+	if c == NamingDirectionFirst {
+		return numStr + "_" + direction + desc + ".sql"
+	}
+	return numStr + desc + "." + direction + ".sql"
+}
+
+// cutLast slices s around the last instance of sep.
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
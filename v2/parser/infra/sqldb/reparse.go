@@ -0,0 +1,50 @@
+package sqldb
+
+import (
+	"cmp"
+	"fmt"
+
+	"encr.dev/pkg/paths"
+)
+
+// ReparseMigrations re-parses the migrations of db from disk and returns an updated
+// copy of db, without re-running the rest of the parse.
+//
+// It's meant for quickly picking up changes to migration files during development,
+// for example when a migration file changes while watching for changes.
+// Changes to the declaration of the database itself, such as its encore:database
+// directive, are not picked up; those require a full parse.
+func ReparseMigrations(mainModuleDir paths.FS, db *Database) (*Database, error) {
+	return reparseMigrations(mainModuleDir, db, nil)
+}
+
+// StreamMigrations is like ReparseMigrations, but calls report with each problem
+// with the migrations as soon as it's found, continuing with the remaining migration
+// files rather than stopping at the first problem. It's meant for interactive use,
+// like editors, to show the first problems of a large migration directory right away.
+//
+// The reported errors have the database name set. The first problem found is
+// also returned, as by ReparseMigrations.
+func StreamMigrations(mainModuleDir paths.FS, db *Database, report func(*MigrationError)) (*Database, error) {
+	return reparseMigrations(mainModuleDir, db, func(err *MigrationError) {
+		if err.Database == "" {
+			err.Database = db.Name
+		}
+		report(err)
+	})
+}
+
+func reparseMigrations(mainModuleDir paths.FS, db *Database, report func(*MigrationError)) (*Database, error) {
+	migrationDir := paths.FS(db.MigrationDir.ToIO(mainModuleDir))
+	migrations, err := validateMigrations(db, migrationDir, report)
+	if err != nil {
+		return nil, fmt.Errorf("parsing db migrations for database %s: %w", db.Name, err)
+	}
+	if err := cmp.Or(checkBaseline(db.Baseline, migrations), checkRequired(db.RequiredMigrations, migrations)); err != nil {
+		return nil, fmt.Errorf("database %s: %w", db.Name, err)
+	}
+
+	updated := *db
+	updated.Migrations = migrations
+	return &updated, nil
+}
@@ -0,0 +1,137 @@
+package sqldb
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/parser/resource/resourceparser"
+)
+
+// reservedName is a table or schema that Encore reserves for its own bookkeeping.
+type reservedName struct {
+	Schema string // the reserved schema, or the schema of the reserved table
+	Table  string // the reserved table, or "" if the whole schema is reserved
+	Reason string // what Encore uses it for
+}
+
+// reservedNames are the tables and schemas that migrations shouldn't create or
+// modify, since doing so may conflict with Encore's internals.
+var reservedNames = []reservedName{
+	{Schema: "public", Table: "schema_migrations", Reason: "Encore's record of the applied migrations"},
+	{Schema: "encore", Reason: "reserved for Encore's internal use"},
+}
+
+// sqlNameList matches a comma-separated list of optionally schema-qualified names.
+const sqlNameList = sqlIdent + `(?:\s*\.\s*` + sqlIdent + `)?(?:\s*,\s*` + sqlIdent + `(?:\s*\.\s*` + sqlIdent + `)?)*`
+
+var (
+	// modifyRe matches the start of statements that create or modify objects,
+	// capturing the statement up to the names of the objects, and the names.
+	modifyRe = regexp.MustCompile(`(?i)^((?:` +
+		`CREATE\s+(?:(?:OR\s+REPLACE|GLOBAL|LOCAL|TEMP|TEMPORARY|UNLOGGED)\s+)*` +
+		`|ALTER\s+|DROP\s+)` +
+		`(?:MATERIALIZED\s+VIEW|FOREIGN\s+TABLE|TABLE|SCHEMA|SEQUENCE|VIEW|FUNCTION|PROCEDURE|TYPE)` +
+		`|TRUNCATE(?:\s+TABLE)?|INSERT\s+INTO|UPDATE|DELETE\s+FROM|COMMENT\s+ON\s+(?:TABLE|SCHEMA)` +
+		`)\s+(?:ONLY\s+)?(?:IF\s+(?:NOT\s+)?EXISTS\s+)?(` + sqlNameList + `)`)
+
+	// createOnRe matches the start of CREATE INDEX and CREATE TRIGGER statements,
+	// capturing the statement up to the name of the table, and the name.
+	createOnRe = regexp.MustCompile(`(?i)^(CREATE\s+(?:OR\s+REPLACE\s+)?(?:UNIQUE\s+|CONSTRAINT\s+)?(?:INDEX|TRIGGER)\b[^;]*?\bON)\s+(?:ONLY\s+)?(` +
+		sqlIdent + `(?:\s*\.\s*` + sqlIdent + `)?)`)
+)
+
+// reservedNameIssues reports the statements in the migrations in the directory dir
+// within fsys that create or modify one of the reservedNames.
+func reservedNameIssues(fsys fs.FS, dir string, migrations []MigrationFile) ([]contentIssue, error) {
+	var issues []contentIssue
+	for _, m := range migrations {
+		data, err := fs.ReadFile(fsys, path.Join(dir, m.Filename))
+		if err != nil {
+			return nil, err
+		}
+		sql := string(data)
+		code := maskSQL(sql)
+		for _, stmt := range statementPositions(sql) {
+			match := modifyRe.FindStringSubmatch(code[stmt:])
+			kind := statementKind(match)
+			if match == nil {
+				match = createOnRe.FindStringSubmatch(code[stmt:])
+				if match == nil {
+					continue
+				}
+				kind = statementKind(match)[:2] // like "CREATE INDEX"
+			}
+			isSchema := strings.HasSuffix(strings.ToUpper(match[1]), "SCHEMA")
+			for _, name := range strings.Split(match[2], ",") {
+				res, ok := reservedNameFor(name, isSchema)
+				if !ok {
+					continue
+				}
+				what := "table " + res.Table
+				if res.Table == "" {
+					what = "schema " + res.Schema
+				}
+				issues = append(issues, contentIssue{
+					Filename: m.Filename,
+					Line:     1 + strings.Count(sql[:stmt], "\n"),
+					Message: fmt.Sprintf("contains %s targeting the reserved %s (%s), which may conflict with Encore's internals",
+						strings.Join(kind, " "), what, res.Reason),
+				})
+			}
+		}
+	}
+	return issues, nil
+}
+
+// statementKind returns the uppercase keywords of the statement matched by modifyRe
+// or createOnRe, without modifiers, like "CREATE TABLE" or "INSERT INTO".
+func statementKind(match []string) []string {
+	if match == nil {
+		return nil
+	}
+	var kind []string
+	for _, kw := range strings.Fields(strings.ToUpper(match[1])) {
+		switch kw {
+		case "OR", "REPLACE", "GLOBAL", "LOCAL", "TEMP", "TEMPORARY", "UNLOGGED", "UNIQUE", "CONSTRAINT":
+		default:
+			kind = append(kind, kw)
+		}
+	}
+	return kind
+}
+
+// reservedNameFor returns the reserved name the object name, as written in SQL,
+// refers to, if any. If isSchema is true, name is the name of a schema.
+func reservedNameFor(name string, isSchema bool) (reservedName, bool) {
+	key := tableKey(name)
+	schema, table, qualified := strings.Cut(key, ".")
+	if !qualified {
+		schema, table = "public", key
+	}
+	if isSchema {
+		schema, table = key, ""
+	}
+	for _, res := range reservedNames {
+		if res.Schema == schema && (res.Table == "" || res.Table == table) {
+			return res, true
+		}
+	}
+	return reservedName{}, false
+}
+
+// warnReservedNames logs a warning for each statement in the database's
+// migrations that creates or modifies a table or schema reserved by Encore.
+func warnReservedNames(p *resourceparser.Pass, migrationDir paths.FS, db *Database) {
+	issues, err := reservedNameIssues(migrationFS(migrationDir), ".", db.Migrations)
+	if err != nil {
+		p.Log.Warn().Err(err).Str("db", db.Name).Msg("unable to check migrations for reserved names")
+		return
+	}
+	for _, issue := range issues {
+		p.Log.Warn().Str("db", db.Name).Str("migration", issue.Filename).Int("line", issue.Line).Msg(issue.String())
+	}
+}
@@ -0,0 +1,106 @@
+package sqldb
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// dropTableRe matches the start of DROP TABLE statements,
+// capturing the comma-separated names of the dropped tables.
+var dropTableRe = regexp.MustCompile(`(?i)^DROP\s+TABLE\s+(?:IF\s+EXISTS\s+)?(` +
+	sqlIdent + `(?:\s*\.\s*` + sqlIdent + `)?(?:\s*,\s*` + sqlIdent + `(?:\s*\.\s*` + sqlIdent + `)?)*)`)
+
+// checkReversible reports the tables created by the up migrations in the directory
+// dir within fsys that their down migrations don't drop, which suggests the down
+// migration doesn't fully reverse the up migration. Up migrations without a down
+// migration, and temporary tables, are skipped.
+//
+// It's a heuristic: a down migration may remove a table in other ways,
+// such as by dropping its schema.
+func checkReversible(fsys fs.FS, dir string, opts migrationOptions, ups []MigrationFile) ([]contentIssue, error) {
+	if opts.IgnoreDowns {
+		return nil, nil
+	}
+	files, err := migrationFileNames(fsys, dir, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	downs := make(map[uint64]string) // migration number -> down filename
+	for _, name := range files {
+		base := path.Base(name)
+		if ignoreMigrationFile(base, opts.Ignore) || filepath.Ext(strings.ToLower(base)) != ".sql" {
+			continue
+		}
+		if down, direction, err := parseMigrationName(base, opts); err == nil && direction == "down" {
+			downs[down.Number] = name
+		}
+	}
+
+	var issues []contentIssue
+	for _, up := range ups {
+		downName, ok := downs[up.Number]
+		if !ok {
+			continue
+		}
+		upSQL, err := fs.ReadFile(fsys, path.Join(dir, up.Filename))
+		if err != nil {
+			return nil, err
+		}
+		downSQL, err := fs.ReadFile(fsys, path.Join(dir, downName))
+		if err != nil {
+			return nil, err
+		}
+
+		dropped := make(map[string]bool)
+		down := string(downSQL)
+		for _, stmt := range statementPositions(down) {
+			if m := dropTableRe.FindStringSubmatch(down[stmt:]); m != nil {
+				for _, name := range strings.Split(m[1], ",") {
+					dropped[tableKey(name)] = true
+				}
+			}
+		}
+
+		sql := string(upSQL)
+		for _, stmt := range statementPositions(sql) {
+			m := createRe.FindStringSubmatch(sql[stmt:])
+			if m == nil || !strings.EqualFold(m[2], "TABLE") || m[4] == "" {
+				continue
+			} else if mods := strings.ToUpper(m[1]); strings.Contains(mods, "TEMP") {
+				continue // dropped at the end of the session
+			}
+			name := strings.Join(strings.Fields(m[4]), "")
+			if !dropped[tableKey(name)] {
+				issues = append(issues, contentIssue{
+					Filename: up.Filename,
+					Line:     1 + strings.Count(sql[:stmt], "\n"),
+					Message:  fmt.Sprintf("creates the table %s, but its down migration %s doesn't drop it", name, downName),
+				})
+			}
+		}
+	}
+	return issues, nil
+}
+
+// tableKey returns the key to compare the table name with, as written in SQL.
+// Unquoted identifiers are folded to lowercase, as by Postgres,
+// and the default "public" schema is removed.
+func tableKey(name string) string {
+	var parts []string
+	for _, part := range strings.Split(strings.Join(strings.Fields(name), ""), ".") {
+		if unquoted, ok := strings.CutPrefix(part, `"`); ok {
+			parts = append(parts, strings.TrimSuffix(unquoted, `"`))
+		} else {
+			parts = append(parts, strings.ToLower(part))
+		}
+	}
+	if len(parts) == 2 && parts[0] == "public" {
+		parts = parts[1:]
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,105 @@
+package sqldb
+
+import (
+	"encoding/json"
+	"errors"
+	"path"
+	"slices"
+)
+
+// MigrationSARIF converts migration validation errors, such as those returned by
+// ValidateAll, into a SARIF 2.1.0 log, for code scanning tools to report them
+// inline in code review.
+//
+// Each error with a MigrationError in its chain becomes a result, with its kind
+// as the rule id. It's located at the migration file, relative to the main module,
+// if the error concerns a specific migration of one of dbs, or at the migration
+// directory otherwise. Other errors are skipped, since they can't be located.
+func MigrationSARIF(dbs []*Database, errs []error) ([]byte, error) {
+	dirs := make(map[string]string) // database name -> migration directory
+	for _, db := range dbs {
+		dirs[db.Name] = string(db.MigrationDir)
+	}
+
+	run := sarifRun{Results: []sarifResult{}}
+	run.Tool.Driver = sarifDriver{Name: "encore", InformationURI: "https://encore.dev/docs/primitives/databases"}
+	for _, err := range errs {
+		var migErr *MigrationError
+		if !errors.As(err, &migErr) {
+			continue
+		}
+		ruleID := "sqldb/" + string(migErr.Kind)
+		if !slices.ContainsFunc(run.Tool.Driver.Rules, func(r sarifRule) bool { return r.ID == ruleID }) {
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: ruleID})
+		}
+
+		res := sarifResult{RuleID: ruleID, Level: "error"}
+		res.Message.Text = migErr.Error()
+		if dir, ok := dirs[migErr.Database]; ok && dir != "" {
+			loc := sarifLocation{}
+			loc.PhysicalLocation.ArtifactLocation = sarifArtifact{URI: dir, URIBaseID: "%SRCROOT%"}
+			if migErr.Filename != "" {
+				loc.PhysicalLocation.ArtifactLocation.URI = path.Join(dir, migErr.Filename)
+				loc.PhysicalLocation.Region = &sarifRegion{StartLine: 1}
+			}
+			res.Locations = []sarifLocation{loc}
+		}
+		run.Results = append(run.Results, res)
+	}
+
+	return json.MarshalIndent(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+}
+
+// The types below model the subset of SARIF used by MigrationSARIF.
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool struct {
+		Driver sarifDriver `json:"driver"`
+	} `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID  string `json:"ruleId"`
+	Level   string `json:"level"`
+	Message struct {
+		Text string `json:"text"`
+	} `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation sarifArtifact `json:"artifactLocation"`
+		Region           *sarifRegion  `json:"region,omitempty"`
+	} `json:"physicalLocation"`
+}
+
+type sarifArtifact struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
@@ -2,6 +2,7 @@ package sqldb
 
 import (
 	"bytes"
+	"cmp"
 	"errors"
 	"fmt"
 	"go/ast"
@@ -11,12 +12,17 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
+	"encore.dev/appruntime/exported/experiments"
 	"encr.dev/pkg/option"
 	"encr.dev/pkg/paths"
+	"encr.dev/v2/internals/perr"
 	"encr.dev/v2/internals/pkginfo"
 	"encr.dev/v2/parser/infra/internal/literals"
 	"encr.dev/v2/parser/infra/internal/parseutil"
@@ -32,6 +38,100 @@ type Database struct {
 	File         option.Option[*pkginfo.File]
 	MigrationDir paths.MainModuleRelSlash
 	Migrations   []MigrationFile
+
+	// Baseline is the migration number up to which (inclusive) the migrations
+	// are considered already applied, and should not be run.
+	// It's used when adopting Encore for an existing database.
+	// Zero means there is no baseline.
+	Baseline uint64
+
+	// LockTimeout is how long to wait for the migration lock
+	// before giving up. Zero means to wait indefinitely.
+	LockTimeout time.Duration
+
+	// ForwardOnly reports whether the database's migrations
+	// are forward-only, meaning they're never rolled back.
+	ForwardOnly bool
+
+	// PostgresVersion is the major Postgres version the migrations target.
+	// Migrations using features not supported by it are reported as warnings.
+	// Zero means unspecified.
+	PostgresVersion int
+
+	// DependsOn are the names of the databases whose migrations
+	// must be applied before this database's migrations.
+	DependsOn []string
+
+	// RequiredMigrations are the descriptions of migrations
+	// the database is required to have.
+	RequiredMigrations []string
+
+	// Naming is the naming convention of the migration files.
+	Naming MigrationNaming
+
+	// Timestamps reports whether the migrations are numbered by
+	// timestamps in the form YYYYMMDDHHMMSS, instead of a 1..N sequence.
+	Timestamps bool
+
+	// Recursive reports whether migrations in subdirectories of the
+	// migration directory are included in the migration sequence.
+	Recursive bool
+
+	// StrictDowns reports whether down migrations are an error
+	// in the forward-only database, instead of being ignored.
+	StrictDowns bool
+
+	// MigrationPrefixes are filename prefixes, added by external tools,
+	// that are stripped from migration filenames before parsing them.
+	MigrationPrefixes []string
+
+	// IgnorePatterns are glob patterns of files in the migration directory
+	// that are skipped when parsing migrations.
+	IgnorePatterns []string
+
+	// StatementSplitting controls how the migrations are split into statements.
+	StatementSplitting StatementSplitting
+
+	// MaxDescriptionLen is the maximum length of migration descriptions.
+	// Zero means DefaultMaxDescriptionLen.
+	MaxDescriptionLen int
+
+	// Gaps is how gaps in the migration number sequence are reported.
+	Gaps GapSeverity
+
+	// ContentChecks are the advisory checks run on the contents of the migrations.
+	ContentChecks []ContentCheck
+
+	// Extensions are the Postgres extensions the database uses.
+	Extensions []string
+
+	// Runner is the path to an external command to apply the migrations with,
+	// instead of Encore's built-in migration runner. It's empty if not declared.
+	Runner paths.MainModuleRelSlash
+}
+
+// migrationOptions returns the options to use when parsing the database's migrations.
+func (d *Database) migrationOptions() migrationOptions {
+	return migrationOptions{
+		Naming:      d.Naming,
+		RejectDowns: d.ForwardOnly && d.StrictDowns,
+		IgnoreDowns: d.ForwardOnly && !d.StrictDowns,
+		Numbering:   d.Numbering(),
+		Recursive:   d.Recursive,
+		Prefixes:    d.MigrationPrefixes,
+		Ignore:      d.IgnorePatterns,
+		Splitting:   d.StatementSplitting,
+
+		MaxDescriptionLen: d.MaxDescriptionLen,
+	}
+}
+
+// Numbering returns the convention the database's migrations are numbered by.
+func (d *Database) Numbering() MigrationNumbering {
+	if d.Timestamps {
+		return TimestampNumbering
+	}
+	return SequentialNumbering
 }
 
 func (d *Database) Kind() resource.Kind       { return resource.SQLDatabase }
@@ -45,6 +145,53 @@ type MigrationFile struct {
 	Filename    string
 	Number      uint64
 	Description string
+
+	// Checksum is the hex-encoded SHA-256 checksum of the file contents.
+	Checksum string
+
+	// Environments are the environments the migration applies to,
+	// declared with a "-- encore:environments local,test" annotation.
+	// Nil means the migration applies to all environments.
+	//
+	// The migration is still part of the migration sequence in all environments;
+	// it's up to the migration runner to skip it where it doesn't apply.
+	Environments []string
+
+	// StatementCount is the number of SQL statements in the migration,
+	// according to the database's statement splitting mode. It's for diagnostics.
+	StatementCount int
+
+	// Author is the author of the migration, declared with a
+	// "-- encore:author Jane Doe <jane@example.com>" annotation, for attribution.
+	// It's empty if not declared.
+	Author string
+
+	// RequiredExtensions are the Postgres extensions the migration requires,
+	// declared with "-- encore:requires-extension pgcrypto" annotations.
+	// They must be declared on the database.
+	RequiredExtensions []string
+
+	// Destructive reports whether the migration is declared as destructive,
+	// like dropping tables or columns with data, with a "-- encore:destructive" annotation.
+	// Tooling may require confirmation before applying it in protected environments.
+	Destructive bool
+
+	// PinnedLast reports whether the migration is pinned to run after all other
+	// migrations, with a "-- encore:pinned-last" annotation. It's for migrations
+	// that must always come last, like granting permissions on all tables.
+	// At most one migration per database may be pinned, and it must have the
+	// highest number, so it's renumbered (and thus applied again) whenever
+	// migrations are added.
+	PinnedLast bool
+
+	// Meta is the structured metadata declared in the migration's
+	// front-matter block, if any. See parseFrontMatter for the supported keys.
+	// Nil means the migration has no front-matter.
+	Meta map[string]any
+
+	// Timestamp is the time the migration number represents, for databases
+	// whose migrations are numbered by timestamp. It's zero otherwise.
+	Timestamp time.Time
 }
 
 var DatabaseParser = &resourceparser.Parser{
@@ -107,7 +254,7 @@ func parseDatabase(d parseutil.ReferenceInfo) {
 	}
 
 	migrationDir := d.Pass.Pkg.FSPath.Join(migDir)
-	if fi, err := os.Stat(migrationDir.ToIO()); errors.Is(err, fs.ErrNotExist) || (err == nil && !fi.IsDir()) {
+	if fi, err := fs.Stat(migrationFS(migrationDir), "."); errors.Is(err, fs.ErrNotExist) || (err == nil && !fi.IsDir()) {
 		errs.Add(errNewDatabaseMigrationDirNotFound.AtGoNode(cfgLit.Expr("Migrations")))
 		return
 	} else if err != nil {
@@ -122,20 +269,32 @@ func parseDatabase(d parseutil.ReferenceInfo) {
 		return
 	}
 
-	migrations, err := parseMigrations(migrationDir)
+	dir, ok := findDocDirective(errs, d.Stack)
+	if !ok {
+		return // error reported by findDocDirective
+	} else if !dir.validateNewDatabase(errs) {
+		return
+	}
+
+	db := newDatabase(dir, d.Pass.Pkg, databaseName)
+	db.AST = d.Call
+	db.Doc = d.Doc
+	migrations, err := validateMigrations(db, migrationDir, nil)
 	if err != nil {
 		errs.Add(errUnableToParseMigrations.AtGoNode(cfgLit.Expr("Migrations")).Wrapping(err))
 		return
+	} else if !dir.validateBaseline(errs, migrations) || !dir.validateRequired(errs, migrations) {
+		return
 	}
-
-	db := &Database{
-		AST:          d.Call,
-		Pkg:          d.Pass.Pkg,
-		Name:         databaseName,
-		Doc:          d.Doc,
-		MigrationDir: paths.MainModuleRelSlash(filepath.ToSlash(relMigrationDir)),
-		Migrations:   migrations,
+	runner, ok := dir.resolveRunner(errs, d.Pass.Pkg.FSPath, d.Pass.MainModuleDir)
+	if !ok {
+		return
 	}
+
+	db.MigrationDir = paths.MainModuleRelSlash(filepath.ToSlash(relMigrationDir))
+	db.Migrations = migrations
+	db.Runner = runner
+	warnAll(d.Pass, migrationDir, db)
 	d.Pass.RegisterResource(db)
 	d.Pass.AddBind(d.File, d.Ident, db)
 }
@@ -145,122 +304,601 @@ var MigrationParser = &resourceparser.Parser{
 
 	InterestingSubdirs: []string{"migrations"},
 	Run: func(p *resourceparser.Pass) {
+		db, ignored := parseImplicitDatabase(p.Errs, p.Pkg, p.MainModuleDir)
 		migrationDir := p.Pkg.FSPath.Join("migrations")
-		migrations, err := parseMigrations(migrationDir)
-		if err != nil {
-			// HACK(andre): We should only look for migration directories inside services,
-			// but when this code runs we don't yet know what services exist.
-			// For now, use some heuristics to guess if this is a service and otherwise ignore it.
-			if !pkgIsLikelyService(p.Pkg) {
-				return
-			}
-
-			err := fmt.Errorf("parsing db migrations in %s: %v", p.Pkg.ImportPath, err)
-			p.Errs.Add(errUnableToParseMigrations.Wrapping(err))
+		if ignored {
+			warnIgnoredMigrations(p, migrationDir)
 			return
-		} else if len(migrations) == 0 {
+		} else if db == nil {
 			return
 		}
+		warnUppercaseName(p, db.Name)
+		warnAll(p, migrationDir, db)
+		p.RegisterResource(db)
+		p.AddImplicitBind(db)
+	},
+}
 
-		// HACK(andre): We also need to do the check here, otherwise we get
-		// spurious databases that are defined outside of services.
-		if !pkgIsLikelyService(p.Pkg) {
-			return
+// parseImplicitDatabase parses the database implicitly defined by pkg's "migrations"
+// directory, reporting any problems to errs. It returns nil if there is no such
+// database or it's invalid. It reports whether the migrations are ignored since
+// pkg doesn't look like a service.
+func parseImplicitDatabase(errs *perr.List, pkg *pkginfo.Package, mainModuleDir paths.FS) (db *Database, ignored bool) {
+	migrationDir := pkg.FSPath.Join("migrations")
+
+	// HACK(andre): We should only look for migration directories inside services,
+	// but when this code runs we don't yet know what services exist.
+	// For now, use some heuristics to guess if this is a service and otherwise ignore it,
+	// so we don't get spurious databases that are defined outside of services.
+	if !pkgIsLikelyService(pkg) {
+		// The package's encore:database directive isn't parsed, so any problems with it
+		// aren't reported; look for migrations with the default options instead.
+		migrations, err := parseMigrationDir(migrationDir, migrationOptions{})
+		return nil, err != nil || len(migrations) > 0
+	}
+
+	dir, ok := findPkgDirective(errs, pkg)
+	if !ok {
+		return nil, false // error reported by findPkgDirective
+	}
+
+	db = newDatabase(dir, pkg, cmp.Or(dir.Name, pkg.Name))
+	migrations, err := validateMigrations(db, migrationDir, nil)
+	if err != nil {
+		err := fmt.Errorf("parsing db migrations in %s: %w", pkg.ImportPath, err)
+		errs.Add(errUnableToParseMigrations.Wrapping(err))
+		return nil, false
+	} else if len(migrations) == 0 {
+		return nil, false
+	}
+
+	// Compute the relative path to the migration directory from the main module.
+	relMigrationDir, err := filepath.Rel(mainModuleDir.ToIO(), migrationDir.ToIO())
+	if err != nil || !filepath.IsLocal(relMigrationDir) {
+		errs.Add(errMigrationsNotInMainModule)
+		return nil, false
+	}
+
+	if !dir.validateBaseline(errs, migrations) || !dir.validateRequired(errs, migrations) {
+		return nil, false
+	}
+	runner, ok := dir.resolveRunner(errs, pkg.FSPath, mainModuleDir)
+	if !ok {
+		return nil, false
+	}
+
+	db.MigrationDir = paths.MainModuleRelSlash(filepath.ToSlash(relMigrationDir))
+	db.Migrations = migrations
+	db.Runner = runner
+	return db, false
+}
+
+// newDatabase returns the database with the given name defined in pkg,
+// configured by its encore:database directive. Its migrations, migration
+// directory and runner are left for the caller to set once validated.
+func newDatabase(dir dbDirective, pkg *pkginfo.Package, name string) *Database {
+	return &Database{
+		Pkg:         pkg,
+		Name:        name,
+		Baseline:    dir.Baseline,
+		LockTimeout: dir.LockTimeout,
+		ForwardOnly: dir.ForwardOnly,
+
+		PostgresVersion: dir.PostgresVersion,
+		DependsOn:       dir.DependsOn,
+
+		RequiredMigrations: dir.RequiredMigrations,
+		Naming:             dir.Naming,
+		StrictDowns:        dir.StrictDowns,
+		Timestamps:         dir.Timestamps,
+		Recursive:          dir.Recursive,
+		MigrationPrefixes:  dir.MigrationPrefixes,
+		IgnorePatterns:     dir.IgnorePatterns,
+		StatementSplitting: dir.StatementSplitting,
+		MaxDescriptionLen:  dir.MaxDescriptionLen,
+		Gaps:               dir.Gaps,
+		ContentChecks:      dir.ContentChecks,
+		Extensions:         dir.Extensions,
+	}
+}
+
+// validateMigrations parses the migrations of db in migrationDir and validates
+// them against its configuration. If report is set, each problem is reported
+// to it as soon as it's found, as described by migrationOptions.Report.
+// The returned error has the database name set.
+func validateMigrations(db *Database, migrationDir paths.FS, report func(*MigrationError)) ([]MigrationFile, error) {
+	opts := db.migrationOptions()
+	opts.Report = report
+	migrations, err := parseMigrationDir(migrationDir, opts)
+	if err == nil {
+		// Problems found parsing the migration files are already reported.
+		if migrations, err = applyTransforms(db.Name, migrations); err == nil && db.Gaps == GapsError {
+			err = checkMigrationGaps(migrations)
+		}
+		if err == nil {
+			err = checkRequiredExtensions(migrations, db.Extensions)
 		}
+		reportMigrationError(report, err)
+	}
+	if err != nil {
+		return nil, withDatabase(err, db.Name)
+	}
+	return migrations, nil
+}
 
-		// Compute the relative path to the migration directory from the main module.
-		relMigrationDir, err := filepath.Rel(p.MainModuleDir.ToIO(), migrationDir.ToIO())
-		if err != nil || !filepath.IsLocal(relMigrationDir) {
-			p.Errs.Add(errMigrationsNotInMainModule)
-			return
+// warnAll logs the advisory warnings about the migrations of db in migrationDir.
+func warnAll(p *resourceparser.Pass, migrationDir paths.FS, db *Database) {
+	warnPostgresVersion(p, migrationDir, db)
+	warnIdenticalMigrations(p, db)
+	warnNumericDescriptions(p, db)
+	warnMigrationGaps(p, db)
+	warnMigrationContents(p, migrationDir, db)
+	warnExecutableMigrations(p, migrationDir, db)
+	warnMismatchedDowns(p, migrationDir, db)
+	warnReservedNames(p, migrationDir, db)
+	warnUntrackedMigrations(p, migrationDir, db)
+	warnGitIgnoredMigrations(p, migrationDir, db)
+}
+
+// MigrationNaming is a convention for naming migration files.
+type MigrationNaming int
+
+const (
+	// NamingDirectionLast is the default naming convention, in the form "1_description.up.sql".
+	NamingDirectionLast MigrationNaming = iota
+	// NamingDirectionFirst is the naming convention in the form "1_up_description.sql".
+	NamingDirectionFirst
+)
+
+var (
+	// migrationRe matches migration filenames using the NamingDirectionLast convention.
+	// The submatches are the number, the description and the direction.
+	migrationRe = regexp.MustCompile(`^(\d+)(_[^.]+)?\.(up|down).sql$`)
+
+	// directionFirstRe matches migration filenames using the NamingDirectionFirst convention.
+	// The submatches are the number, the direction and the description.
+	directionFirstRe = regexp.MustCompile(`^(\d+)_(up|down)(_[^.]+)?\.sql$`)
+)
+
+// match matches the filename against the naming convention,
+// returning the number, description and direction submatches.
+func (c MigrationNaming) match(filename string) (num, desc, direction string, ok bool) {
+	switch c {
+	case NamingDirectionFirst:
+		if m := directionFirstRe.FindStringSubmatch(filename); m != nil {
+			return m[1], m[3], m[2], true
+		}
+	default:
+		if m := migrationRe.FindStringSubmatch(filename); m != nil {
+			return m[1], m[2], m[3], true
+		}
+	}
+	return "", "", "", false
+}
+
+// format returns a description of the expected filename format, for error messages.
+func (c MigrationNaming) format() string {
+	if c == NamingDirectionFirst {
+		return "[123]_[up|down]_[description].sql"
+	}
+	return "[123]_[description].[up|down].sql"
+}
+
+// migrationOptions configures how migrations are parsed and validated.
+type migrationOptions struct {
+	// Naming is the naming convention migration files use.
+	Naming MigrationNaming
+
+	// RejectDowns, if true, makes down migrations an error
+	// instead of being ignored.
+	RejectDowns bool
+
+	// IgnoreDowns, if true, skips validating down migrations,
+	// for databases where they're never used.
+	IgnoreDowns bool
+
+	// Numbering is the convention the migration numbers must follow.
+	// Nil means SequentialNumbering.
+	Numbering MigrationNumbering
+
+	// Recursive, if true, includes migrations in subdirectories of the migration
+	// directory, such as "2024/1_foo.up.sql", validated as a single sequence.
+	Recursive bool
+
+	// Prefixes are filename prefixes to strip before parsing migration filenames,
+	// such as "gen_" for files named like "gen_0001_foo.up.sql".
+	Prefixes []string
+
+	// Ignore are glob patterns, as accepted by path.Match, of filenames to skip,
+	// such as "*.disabled" for temporarily disabled migrations.
+	Ignore []string
+
+	// Splitting controls how migrations are split into statements,
+	// for computing their statement counts.
+	Splitting StatementSplitting
+
+	// MaxDescriptionLen is the maximum length of migration descriptions,
+	// in characters. Zero means DefaultMaxDescriptionLen.
+	MaxDescriptionLen int
+
+	// Report, if set, is called with each problem with the migrations as soon as
+	// it's found, and parsing continues with the remaining migration files
+	// instead of stopping at the first problem.
+	Report func(*MigrationError)
+}
+
+// numbering returns the numbering convention to use.
+func (o migrationOptions) numbering() MigrationNumbering {
+	if o.Numbering == nil {
+		return SequentialNumbering
+	}
+	return o.Numbering
+}
+
+// DefaultMaxDescriptionLen is the default maximum length of migration descriptions.
+// It's conservative so that migration paths stay well within the 260 character
+// path length limit on Windows.
+const DefaultMaxDescriptionLen = 100
+
+// ParseMigrationName parses a single migration filename, like "1_create_users.up.sql",
+// using the default naming convention. It doesn't read the file, so only
+// the Filename, Number and Description fields of the result are set.
+//
+// Both up and down migrations are accepted; the result describes
+// the migration the file belongs to.
+func ParseMigrationName(name string) (MigrationFile, error) {
+	m, _, err := parseMigrationName(name, migrationOptions{})
+	return m, err
+}
+
+// parseMigrationName parses a single migration filename according to the naming convention,
+// after stripping the first matching prefix, if any.
+// It reports the direction of the migration, either "up" or "down".
+func parseMigrationName(name string, opts migrationOptions) (m MigrationFile, direction string, err error) {
+	naming := opts.Naming
+	unprefixed := name
+	for _, prefix := range opts.Prefixes {
+		if rest, ok := strings.CutPrefix(name, prefix); ok {
+			unprefixed = rest
+			break
 		}
+	}
 
-		res := &Database{
-			Pkg:          p.Pkg,
-			Name:         p.Pkg.Name,
-			MigrationDir: paths.MainModuleRelSlash(filepath.ToSlash(relMigrationDir)),
-			Migrations:   migrations,
+	numStr, desc, direction, ok := naming.match(unprefixed)
+	if !ok {
+		// Names like "-1_foo.up.sql" don't match the naming convention at all,
+		// but are clearly meant to have a (negative) number, so say so.
+		if _, _, _, ok := naming.match(strings.TrimPrefix(unprefixed, "-")); ok {
+			return m, "", &MigrationError{Filename: name, Kind: MigrationErrInvalidNumber,
+				Detail: "invalid version number (must be positive, not negative)"}
 		}
-		p.RegisterResource(res)
-		p.AddImplicitBind(res)
-	},
+		return m, "", &MigrationError{Filename: name, Kind: MigrationErrInvalidName,
+			Detail: fmt.Sprintf("invalid name (must be of the format '%s')", naming.format())}
+	}
+	num, err := strconv.ParseUint(numStr, 10, 64)
+	if err != nil {
+		return m, "", &MigrationError{Filename: name, Kind: MigrationErrInvalidNumber,
+			Detail: fmt.Sprintf("invalid version number %q (could not be parsed as an integer: %v)", numStr, errors.Unwrap(err)), Err: err}
+	} else if num == 0 {
+		return m, "", &MigrationError{Filename: name, Kind: MigrationErrInvalidNumber,
+			Detail: "invalid version number 0 (must be positive, not zero)"}
+	}
+
+	m = MigrationFile{
+		Filename:    name,
+		Number:      num,
+		Description: strings.TrimPrefix(desc, "_"),
+	}
+	ts, err := opts.numbering().CheckNumber(numStr, num)
+	if err != nil {
+		return MigrationFile{}, "", &MigrationError{Filename: name, Kind: MigrationErrInvalidNumber, Actual: num,
+			Detail: err.Error()}
+	}
+	m.Timestamp = ts
+	return m, direction, nil
+}
+
+// migrationFS returns the filesystem rooted at the migration directory.
+// All filesystem access by the parser goes through it, so tests can
+// replace it to supply in-memory migrations.
+var migrationFS = func(migrationDir paths.FS) fs.FS {
+	return os.DirFS(migrationDir.ToIO())
+}
+
+// parseMigrationDir parses the migrations in the given directory.
+func parseMigrationDir(migrationDir paths.FS, opts migrationOptions) ([]MigrationFile, error) {
+	migrations, err := parseMigrations(migrationFS(migrationDir), ".", opts)
+	if err != nil {
+		return nil, describePermissionError(err, migrationDir.ToIO())
+	}
+	return migrations, nil
+}
+
+// ignoreMigrationFile reports whether the file name matches any of the ignore patterns.
+func ignoreMigrationFile(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// migrationFileNames returns the slash-separated paths, relative to dir, of the files
+// in the directory dir within fsys, in lexical order. If opts.Recursive is set, it
+// includes the files in subdirectories, except for those matching opts.Ignore.
+func migrationFileNames(fsys fs.FS, dir string, opts migrationOptions) ([]string, error) {
+	var names []string
+	if !opts.Recursive {
+		entries, err := fs.ReadDir(fsys, dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if !e.IsDir() {
+				names = append(names, e.Name())
+			}
+		}
+		return names, nil
+	}
+
+	err := fs.WalkDir(fsys, dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		} else if d.IsDir() {
+			if p != dir && ignoreMigrationFile(d.Name(), opts.Ignore) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if dir != "." {
+			p = strings.TrimPrefix(p, dir+"/")
+		}
+		names = append(names, p)
+		return nil
+	})
+	return names, err
 }
 
-var migrationRe = regexp.MustCompile(`^(\d+)(_[^.]+)?\.(up|down).sql$`)
+// describePermissionError rewrites the description of a MigrationError caused by
+// insufficient permissions to include the absolute path and a hint about how to fix it,
+// since the underlying error is often opaque. Other errors are returned unchanged.
+func describePermissionError(err error, absDir string) error {
+	var migErr *MigrationError
+	if !errors.Is(err, fs.ErrPermission) || !errors.As(err, &migErr) {
+		return err
+	}
+
+	path := absDir
+	if migErr.Filename != "" {
+		path = filepath.Join(absDir, migErr.Filename)
+	}
+	migErr.Detail = fmt.Sprintf("permission denied reading %s (check that it's readable by the user running Encore)", path)
+	return err
+}
 
-func parseMigrations(migrationDir paths.FS) ([]MigrationFile, error) {
-	files, err := os.ReadDir(migrationDir.ToIO())
+// parseMigrations parses and validates the migrations in the directory dir within fsys.
+// It allows validating migrations that aren't stored on disk, such as embedded migrations.
+func parseMigrations(fsys fs.FS, dir string, opts migrationOptions) ([]MigrationFile, error) {
+	files, err := migrationFileNames(fsys, dir, opts)
 	if err != nil {
-		return nil, fmt.Errorf("could not read migrations: %v", err)
+		return nil, &MigrationError{Kind: MigrationErrRead, Detail: fmt.Sprintf("could not read migrations: %v", err), Err: err}
 	}
 	migrations := make([]MigrationFile, 0, len(files))
-	for _, f := range files {
-		if f.IsDir() {
-			continue
+	lowerNames := make(map[string]string, len(files))
+	var downs []MigrationFile
+
+	// parseFile parses the migration file name, adding it to migrations or downs.
+	parseFile := func(name string) error {
+		base := path.Base(name)
+		if ignoreMigrationFile(base, opts.Ignore) {
+			return nil
 		}
 
 		// If the file is not an SQL file ignore it, to allow for other files to be present
 		// in the migration directory. For SQL files we want to ensure they're properly named
 		// so that we complain loudly about potential typos. (It's theoretically possible to
 		// typo the filename extension as well, but it's less likely due to syntax highlighting).
-		if filepath.Ext(strings.ToLower(f.Name())) != ".sql" {
-			continue
+		if filepath.Ext(strings.ToLower(base)) != ".sql" {
+			return nil
 		}
 
-		match := migrationRe.FindStringSubmatch(f.Name())
-		if match == nil {
-			return nil, fmt.Errorf("db migration %s: invalid name (must be of the format '[123]_[description].[up|down].sql')",
-				f.Name())
+		// Filenames differing only by case collide on case-insensitive filesystems
+		// (the default on macOS and Windows), so reject them for portability.
+		lower := strings.ToLower(name)
+		if other, ok := lowerNames[lower]; ok {
+			return &MigrationError{Filename: name, Kind: MigrationErrCaseCollision,
+				Detail: fmt.Sprintf("filename differs from %s only by case, which is not portable across filesystems", other)}
 		}
-		num, err := strconv.ParseUint(match[1], 10, 64)
+		lowerNames[lower] = name
+
+		mig, direction, err := parseMigrationName(base, opts)
 		if err != nil {
-			return nil, fmt.Errorf("db migration %s: invalid version number %q (must be a positive integer)",
-				f.Name(), match[1])
+			var migErr *MigrationError
+			if errors.As(err, &migErr) {
+				migErr.Filename = name
+			}
+			return err
+		}
+		mig.Filename = name
+		maxLen := cmp.Or(opts.MaxDescriptionLen, DefaultMaxDescriptionLen)
+		if n := utf8.RuneCountInString(mig.Description); n > maxLen {
+			return &MigrationError{Filename: name, Kind: MigrationErrDescriptionTooLong, Expected: uint64(maxLen), Actual: uint64(n),
+				Detail: fmt.Sprintf("description is too long (%d characters, the maximum is %d)", n, maxLen)}
 		}
 
-		description := strings.TrimPrefix(match[2], "_")
-		if match[3] == "up" {
-			migrations = append(migrations, MigrationFile{
-				Filename:    f.Name(),
-				Number:      num,
-				Description: description,
-			})
+		if direction == "down" && opts.RejectDowns {
+			return &MigrationError{Filename: name, Kind: MigrationErrDownNotAllowed,
+				Detail: "down migrations are not allowed in forward-only databases"}
+		} else if direction == "down" && !opts.IgnoreDowns {
+			downs = append(downs, mig)
+		} else if direction == "up" {
+			data, err := fs.ReadFile(fsys, path.Join(dir, name))
+			if err != nil {
+				return &MigrationError{Filename: name, Kind: MigrationErrRead,
+					Detail: fmt.Sprintf("could not read migration: %v", err), Err: err}
+			}
+			if off := invalidUTF8Offset(data); off >= 0 {
+				return &MigrationError{Filename: name, Kind: MigrationErrInvalidEncoding,
+					Detail: fmt.Sprintf("migration is not valid UTF-8: invalid byte sequence at offset %d", off)}
+			}
+			if line, marker := findConflictMarker(data); line > 0 {
+				return &MigrationError{Filename: name, Kind: MigrationErrConflictMarkers,
+					Detail: fmt.Sprintf("unresolved merge conflict: line %d contains the conflict marker %q", line, marker)}
+			}
+			annotations, err := parseAnnotations(data)
+			if err != nil {
+				return &MigrationError{Filename: name, Kind: MigrationErrInvalidAnnotation,
+					Detail: err.Error(), Err: err}
+			}
+			meta, err := parseFrontMatter(data)
+			if err != nil {
+				return &MigrationError{Filename: name, Kind: MigrationErrInvalidAnnotation,
+					Detail: err.Error(), Err: err}
+			}
+			mig.Checksum = Checksum(data)
+			mig.StatementCount = len(splitStatements(string(data), opts.Splitting))
+			mig.Environments = annotations.Environments
+			mig.Author = annotations.Author
+			mig.RequiredExtensions = annotations.RequiredExtensions
+			mig.Destructive = annotations.Destructive
+			mig.PinnedLast = annotations.PinnedLast
+			mig.Meta = meta
+			if envs, ok := meta["environments"].([]string); ok {
+				if mig.Environments != nil {
+					return &MigrationError{Filename: name, Kind: MigrationErrInvalidAnnotation,
+						Detail: "environments declared both in front-matter and with an encore:environments annotation"}
+				}
+				mig.Environments = envs
+			}
+			migrations = append(migrations, mig)
+		}
+		return nil
+	}
+
+	// Unless a reporter is set, parsing stops at the first problem.
+	// Otherwise each problem is reported, and the first one returned once all files are parsed.
+	var firstErr error
+	for _, name := range files {
+		if err := parseFile(name); err != nil && opts.Report == nil {
+			return nil, err
+		} else if err != nil {
+			reportMigrationError(opts.Report, err)
+			firstErr = cmp.Or(firstErr, err)
 		}
 	}
-	sort.Slice(migrations, func(i, j int) bool {
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	sort.SliceStable(migrations, func(i, j int) bool {
 		return migrations[i].Number < migrations[j].Number
 	})
 
+	fail := func(err error) ([]MigrationFile, error) {
+		reportMigrationError(opts.Report, err)
+		return nil, err
+	}
+
 	// Catch invalid migration numbers.
-	seen := make(map[uint64]bool, len(migrations))
+	if opts.numbering() == TimestampNumbering {
+		if err := checkTimestampCollisions(migrations); err != nil {
+			return fail(err)
+		}
+	}
+	if err := checkMigrationOrder(migrations); err != nil {
+		return fail(err)
+	}
+
+	if err := checkOrphanedDowns(migrations, downs); err != nil {
+		return fail(err)
+	}
+
 	for _, mig := range migrations {
-		fn, num := mig.Filename, mig.Number
-		if num <= 0 {
-			return nil, fmt.Errorf("db migration %s: invalid migration number %d", fn, num)
-		} else if seen[num] {
-			return nil, fmt.Errorf("db migration %s: duplicate migration with number %d", fn, num)
+		if err := VerifyChecksum(fsys, dir, mig); err != nil && opts.Report == nil {
+			return nil, err
+		} else if err != nil {
+			reportMigrationError(opts.Report, err)
+			firstErr = cmp.Or(firstErr, err)
 		}
-		seen[num] = true
+	}
+	if firstErr != nil {
+		return nil, firstErr
 	}
 
+	if err := checkPinnedLast(migrations); err != nil {
+		return fail(err)
+	}
 	return migrations, nil
 }
 
+// checkPinnedLast checks that at most one of the migrations, ordered by number,
+// is pinned to run last, and that it has the highest number. The migration runner
+// applies migrations by number, so a pinned migration with a lower number would
+// neither run last nor run again, and would cause later migrations to be skipped.
+func checkPinnedLast(migrations []MigrationFile) error {
+	idx := -1
+	for i, mig := range migrations {
+		if !mig.PinnedLast {
+			continue
+		} else if idx >= 0 {
+			return &MigrationError{Filename: mig.Filename, Kind: MigrationErrInvalidAnnotation,
+				Detail: fmt.Sprintf("only one migration can be pinned last, but %s is also pinned", migrations[idx].Filename)}
+		}
+		idx = i
+	}
+	if idx >= 0 && idx < len(migrations)-1 {
+		last := migrations[len(migrations)-1]
+		return &MigrationError{Filename: migrations[idx].Filename, Kind: MigrationErrInvalidAnnotation,
+			Detail: fmt.Sprintf("a migration pinned last must have the highest number, but %s comes after it; "+
+				"renumber it so it's greater than %d", last.Filename, last.Number)}
+	}
+	return nil
+}
+
+// checkOrphanedDowns checks that every down migration has a corresponding
+// up migration with the same number, which catches renames where only
+// the up migration was updated.
+func checkOrphanedDowns(ups, downs []MigrationFile) error {
+	for _, down := range downs {
+		found := slices.ContainsFunc(ups, func(up MigrationFile) bool {
+			return up.Number == down.Number
+		})
+		if !found {
+			return &MigrationError{Filename: down.Filename, Kind: MigrationErrOrphanedDown, Expected: down.Number,
+				Detail: fmt.Sprintf("down migration has no corresponding up migration (missing up migration number %d)", down.Number)}
+		}
+	}
+	return nil
+}
+
+// warnUppercaseName logs a warning if the database name contains uppercase letters.
+// Postgres folds unquoted identifiers to lowercase, so such names are easily
+// mixed up with their lowercase equivalent.
+func warnUppercaseName(p *resourceparser.Pass, name string) {
+	if lower := strings.ToLower(name); lower != name {
+		p.Log.Warn().Str("db", name).
+			Msgf("database name %q contains uppercase letters, which Postgres folds to lowercase in unquoted identifiers; consider naming it %q", name, lower)
+	}
+}
+
+// serviceMarkers are the markers pkgIsLikelyService looks for
+// to determine if a package is likely to be a service.
+var serviceMarkers = []string{
+	"encore:api",
+	"pubsub.NewSubscription",
+	"encore:authhandler",
+	"encore:service",
+}
+
 func pkgIsLikelyService(pkg *pkginfo.Package) bool {
 	isLikelyService := func(file *pkginfo.File) bool {
 		contents := file.Contents()
-		switch {
-		case bytes.Contains(contents, []byte("encore:api")):
-			return true
-		case bytes.Contains(contents, []byte("pubsub.NewSubscription")):
-			return true
-		case bytes.Contains(contents, []byte("encore:authhandler")):
-			return true
-		case bytes.Contains(contents, []byte("encore:service")):
-			return true
-		default:
-			return false
+		for _, marker := range serviceMarkers {
+			if bytes.Contains(contents, []byte(marker)) {
+				return true
+			}
 		}
+		return false
 	}
 
 	for _, file := range pkg.Files {
@@ -270,3 +908,17 @@ func pkgIsLikelyService(pkg *pkginfo.Package) bool {
 	}
 	return false
 }
+
+// warnIgnoredMigrations logs a warning that the migrations in migrationDir
+// are ignored since the package isn't detected as a service.
+// It's opt-in using the "warn-ignored-migrations" experiment.
+func warnIgnoredMigrations(p *resourceparser.Pass, migrationDir paths.FS) {
+	if !experiments.WarnIgnoredMigrations.Enabled(p.Build.Experiments) || len(p.Pkg.Files) == 0 {
+		return
+	}
+	p.Log.Warn().
+		Str("pkg", p.Pkg.ImportPath.String()).
+		Str("dir", migrationDir.ToIO()).
+		Strs("markers_checked", serviceMarkers).
+		Msg("found migrations in a non-service package; they will be ignored")
+}
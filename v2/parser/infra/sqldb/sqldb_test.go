@@ -1,12 +1,31 @@
 package sqldb
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"testing/fstest"
+	"time"
 
+	qt "github.com/frankban/quicktest"
+
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/internals/pkginfo"
+	"encr.dev/v2/parser/resource"
 	"encr.dev/v2/parser/resource/resourcetest"
+	"encr.dev/v2/parser/resource/usage"
 )
 
 func TestParseDatabase(t *testing.T) {
+	emptyChecksum := Checksum(nil)
+
 	tests := []resourcetest.Case[*Database]{
 		{
 			Name: "constructor",
@@ -29,17 +48,338 @@ var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
 })
 -- some/migration/path/1_foo.up.sql --
 CREATE TABLE foo (id int);
+-- some/migration/path/README.md --
 `,
 			Want: &Database{
 				Name:         "name",
 				MigrationDir: "some/migration/path",
 				Migrations: []MigrationFile{{
-					Filename:    "1_foo.up.sql",
-					Number:      1,
-					Description: "foo",
+					Filename:       "1_foo.up.sql",
+					Number:         1,
+					Description:    "foo",
+					Checksum:       Checksum([]byte("CREATE TABLE foo (id int);\n")),
+					StatementCount: 1,
 				}},
 			},
 		},
+		{
+			Name: "baseline",
+			Code: `
+//encore:database baseline=2
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/1_foo.up.sql --
+-- some/migration/path/2_bar.up.sql --
+-- some/migration/path/3_baz.up.sql --
+-- some/migration/path/README.md --
+`,
+			Want: &Database{
+				Name:         "name",
+				MigrationDir: "some/migration/path",
+				Migrations: []MigrationFile{
+					{Filename: "1_foo.up.sql", Number: 1, Description: "foo", Checksum: emptyChecksum},
+					{Filename: "2_bar.up.sql", Number: 2, Description: "bar", Checksum: emptyChecksum},
+					{Filename: "3_baz.up.sql", Number: 3, Description: "baz", Checksum: emptyChecksum},
+				},
+				Baseline: 2,
+			},
+		},
+		{
+			Name: "baseline_unknown_migration",
+			Code: `
+//encore:database baseline=5
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/1_foo.up.sql --
+`,
+			WantErrs: []string{`.*baseline 5 does not match any migration.*`},
+		},
+		{
+			Name: "lock_timeout",
+			Code: `
+//encore:database locktimeout=1m30s
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/foo.txt --
+`,
+			Want: &Database{
+				Name:         "name",
+				MigrationDir: "some/migration/path",
+				LockTimeout:  90 * time.Second,
+			},
+		},
+		{
+			Name: "lock_timeout_invalid",
+			Code: `
+//encore:database locktimeout=-5s
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/foo.txt --
+`,
+			WantErrs: []string{`.*locktimeout must be a positive duration.*`},
+		},
+		{
+			Name: "forward_only",
+			Code: `
+//encore:database forwardonly
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/1_foo.down.sql --
+-- some/migration/path/README.md --
+`,
+			Want: &Database{
+				Name:         "name",
+				MigrationDir: "some/migration/path",
+				ForwardOnly:  true,
+			},
+		},
+		{
+			Name: "forward_only_strict_downs",
+			Code: `
+//encore:database forwardonly strictdowns
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/1_foo.down.sql --
+`,
+			WantErrs: []string{`.*down migrations are not allowed in forward-only databases.*`},
+		},
+		{
+			Name: "strict_downs_without_forward_only",
+			Code: `
+//encore:database strictdowns
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/foo.txt --
+`,
+			WantErrs: []string{`.*strictdowns requires the forwardonly option.*`},
+		},
+		{
+			Name: "pg_version",
+			Code: `
+//encore:database pgversion=15
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/foo.txt --
+`,
+			Want: &Database{
+				Name:            "name",
+				MigrationDir:    "some/migration/path",
+				PostgresVersion: 15,
+			},
+		},
+		{
+			Name: "depends_on",
+			Code: `
+//encore:database dependson=users,billing
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/foo.txt --
+`,
+			Want: &Database{
+				Name:         "name",
+				MigrationDir: "some/migration/path",
+				DependsOn:    []string{"users", "billing"},
+			},
+		},
+		{
+			Name: "required_migrations",
+			Code: `
+//encore:database require=audit_log
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/1_audit_log.up.sql --
+-- some/migration/path/README.md --
+`,
+			Want: &Database{
+				Name:         "name",
+				MigrationDir: "some/migration/path",
+				Migrations: []MigrationFile{
+					{Filename: "1_audit_log.up.sql", Number: 1, Description: "audit_log", Checksum: emptyChecksum},
+				},
+				RequiredMigrations: []string{"audit_log"},
+			},
+		},
+		{
+			Name: "required_migrations_missing",
+			Code: `
+//encore:database require=audit_log
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/1_foo.up.sql --
+`,
+			WantErrs: []string{`.*requires a migration with the description "audit_log".*`},
+		},
+		{
+			Name: "migration_prefix",
+			Code: `
+//encore:database prefix=gen_
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/gen_0001_foo.up.sql --
+-- some/migration/path/2_bar.up.sql --
+-- some/migration/path/README.md --
+`,
+			Want: &Database{
+				Name:         "name",
+				MigrationDir: "some/migration/path",
+				Migrations: []MigrationFile{
+					{Filename: "gen_0001_foo.up.sql", Number: 1, Description: "foo", Checksum: emptyChecksum},
+					{Filename: "2_bar.up.sql", Number: 2, Description: "bar", Checksum: emptyChecksum},
+				},
+				MigrationPrefixes: []string{"gen_"},
+			},
+		},
+		{
+			Name: "migration_prefix_invalid",
+			Code: `
+//encore:database prefix=../gen
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/foo.txt --
+`,
+			WantErrs: []string{`.*prefix must be a comma-separated list of prefixes.*`},
+		},
+		{
+			Name: "runner",
+			Code: `
+//encore:database runner=tools/migrate
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/foo.txt --
+-- tools/migrate --
+`,
+			Want: &Database{
+				Name:         "name",
+				MigrationDir: "some/migration/path",
+				Runner:       "tools/migrate",
+			},
+		},
+		{
+			Name: "runner_not_found",
+			Code: `
+//encore:database runner=tools/migrate
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/foo.txt --
+`,
+			WantErrs: []string{`.*runner command tools/migrate not found.*`},
+		},
+		{
+			Name: "runner_non_local",
+			Code: `
+//encore:database runner=../migrate
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/foo.txt --
+`,
+			WantErrs: []string{`.*runner must be a relative path within the package directory.*`},
+		},
+		{
+			Name: "gaps_error",
+			Code: `
+//encore:database gaps=error
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/1_foo.up.sql --
+-- some/migration/path/3_bar.up.sql --
+`,
+			WantErrs: []string{`.*missing migration number 2 \(expected after 1_foo.up.sql\).*`},
+		},
+		{
+			Name: "gaps_warn",
+			Code: `
+//encore:database gaps=warn
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/1_foo.up.sql --
+-- some/migration/path/3_bar.up.sql --
+-- some/migration/path/README.md --
+`,
+			Want: &Database{
+				Name:         "name",
+				MigrationDir: "some/migration/path",
+				Migrations: []MigrationFile{
+					{Filename: "1_foo.up.sql", Number: 1, Description: "foo", Checksum: emptyChecksum},
+					{Filename: "3_bar.up.sql", Number: 3, Description: "bar", Checksum: emptyChecksum},
+				},
+				Gaps: GapsWarn,
+			},
+		},
+		{
+			Name: "ignore_invalid",
+			Code: `
+//encore:database ignore=[foo
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/1_foo.up.sql --
+`,
+			WantErrs: []string{`.*ignore must be a comma-separated list of filename glob patterns.*`},
+		},
+		{
+			Name: "extensions",
+			Code: `
+//encore:database extensions=pgcrypto
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/1_foo.up.sql --
+-- encore:requires-extension pgcrypto
+SELECT gen_random_uuid();
+-- some/migration/path/README.md --
+`,
+			Want: &Database{
+				Name:         "name",
+				MigrationDir: "some/migration/path",
+				Migrations: []MigrationFile{
+					{Filename: "1_foo.up.sql", Number: 1, Description: "foo", StatementCount: 1,
+						Checksum:           Checksum([]byte("-- encore:requires-extension pgcrypto\nSELECT gen_random_uuid();\n")),
+						RequiredExtensions: []string{"pgcrypto"}},
+				},
+				Extensions: []string{"pgcrypto"},
+			},
+		},
+		{
+			Name: "extensions_missing",
+			Code: `
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/1_foo.up.sql --
+-- encore:requires-extension pgcrypto
+SELECT gen_random_uuid();
+`,
+			WantErrs: []string{`.*requires the extension pgcrypto, which is not declared on the database.*`},
+		},
+		{
+			Name: "name_on_new_database",
+			Code: `
+//encore:database name=billing
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "some/migration/path",
+})
+-- some/migration/path/1_foo.up.sql --
+`,
+			WantErrs: []string{`.*name can only be set for databases defined by a migrations directory.*`},
+		},
 		{
 			Name: "abs_path",
 			Code: `
@@ -62,3 +402,1337 @@ var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
 
 	resourcetest.Run(t, DatabaseParser, tests)
 }
+
+func TestParseMigrations(t *testing.T) {
+	emptyChecksum := Checksum(nil)
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		opts    migrationOptions
+		want    []MigrationFile
+		wantErr string
+	}{
+		{
+			name: "basic",
+			files: fstest.MapFS{
+				"migrations/2_bar.up.sql":   {},
+				"migrations/1_foo.up.sql":   {},
+				"migrations/1_foo.down.sql": {},
+				"migrations/README.md":      {},
+			},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo", Checksum: emptyChecksum},
+				{Filename: "2_bar.up.sql", Number: 2, Description: "bar", Checksum: emptyChecksum},
+			},
+		},
+		{
+			name: "description_too_long",
+			files: fstest.MapFS{
+				"migrations/1_" + strings.Repeat("x", 101) + ".up.sql": {},
+			},
+			wantErr: `db migration 1_x+\.up\.sql: description is too long \(101 characters, the maximum is 100\)`,
+		},
+		{
+			name: "timestamps",
+			files: fstest.MapFS{
+				"migrations/20240102150405_foo.up.sql": {},
+				"migrations/20231231235959_bar.up.sql": {},
+			},
+			opts: migrationOptions{Numbering: TimestampNumbering},
+			want: []MigrationFile{
+				{Filename: "20231231235959_bar.up.sql", Number: 20231231235959, Description: "bar", Checksum: emptyChecksum,
+					Timestamp: time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC)},
+				{Filename: "20240102150405_foo.up.sql", Number: 20240102150405, Description: "foo", Checksum: emptyChecksum,
+					Timestamp: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)},
+			},
+		},
+		{
+			name: "timestamps_invalid",
+			files: fstest.MapFS{
+				"migrations/20241301000000_foo.up.sql": {},
+			},
+			opts:    migrationOptions{Numbering: TimestampNumbering},
+			wantErr: `db migration 20241301000000_foo.up.sql: invalid version number 20241301000000 \(must be a UTC timestamp in the form YYYYMMDDHHMMSS\)`,
+		},
+		{
+			name: "timestamps_same_second",
+			files: fstest.MapFS{
+				"migrations/20240102150405_foo.up.sql": {},
+				"migrations/20240102150405_bar.up.sql": {},
+				"migrations/20240102150406_baz.up.sql": {},
+			},
+			opts: migrationOptions{Numbering: TimestampNumbering},
+			wantErr: `db migration 20240102150405_foo.up.sql: duplicate migration timestamp 20240102150405, shared with 20240102150405_bar.up.sql ` +
+				`\(both created at 2024-01-02 15:04:05 UTC\); renumber one of them so the timestamps are strictly increasing`,
+		},
+		{
+			name: "timestamps_same_second_recursive",
+			files: fstest.MapFS{
+				"migrations/main/20240102150405_foo.up.sql":    {},
+				"migrations/feature/20240102150405_bar.up.sql": {},
+			},
+			opts:    migrationOptions{Numbering: TimestampNumbering, Recursive: true},
+			wantErr: `db migration main/20240102150405_foo.up.sql: duplicate migration timestamp 20240102150405, shared with feature/20240102150405_bar.up.sql .*`,
+		},
+		{
+			name: "timestamps_sequence",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {},
+			},
+			opts:    migrationOptions{Numbering: TimestampNumbering},
+			wantErr: `db migration 1_foo.up.sql: invalid version number 1 \(must be a UTC timestamp in the form YYYYMMDDHHMMSS\)`,
+		},
+		{
+			name: "recursive",
+			files: fstest.MapFS{
+				"migrations/2024/3_baz.up.sql":         {},
+				"migrations/2023/1_foo.up.sql":         {},
+				"migrations/2023/2_bar.up.sql":         {},
+				"migrations/2023/2_bar.down.sql":       {},
+				"migrations/old/9_skipped.up.sql":      {},
+				"migrations/4_top.up.sql":              {},
+				"migrations/2024/nested/5_deep.up.sql": {},
+				"migrations/2024/nested/README.md":     {},
+			},
+			opts: migrationOptions{Recursive: true, Ignore: []string{"old"}},
+			want: []MigrationFile{
+				{Filename: "2023/1_foo.up.sql", Number: 1, Description: "foo", Checksum: emptyChecksum},
+				{Filename: "2023/2_bar.up.sql", Number: 2, Description: "bar", Checksum: emptyChecksum},
+				{Filename: "2024/3_baz.up.sql", Number: 3, Description: "baz", Checksum: emptyChecksum},
+				{Filename: "4_top.up.sql", Number: 4, Description: "top", Checksum: emptyChecksum},
+				{Filename: "2024/nested/5_deep.up.sql", Number: 5, Description: "deep", Checksum: emptyChecksum},
+			},
+		},
+		{
+			name: "recursive_duplicate",
+			files: fstest.MapFS{
+				"migrations/2023/1_foo.up.sql": {},
+				"migrations/2024/1_bar.up.sql": {},
+			},
+			opts:    migrationOptions{Recursive: true},
+			wantErr: `db migration 2024/1_bar.up.sql: duplicate migration with number 1`,
+		},
+		{
+			name: "recursive_invalid_name",
+			files: fstest.MapFS{
+				"migrations/2023/foo.up.sql": {},
+			},
+			opts:    migrationOptions{Recursive: true},
+			wantErr: `db migration 2023/foo.up.sql: invalid name .*`,
+		},
+		{
+			name: "subdirectories_ignored",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql":      {},
+				"migrations/2023/2_bar.up.sql": {},
+			},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo", Checksum: emptyChecksum},
+			},
+		},
+		{
+			name: "description_max_len",
+			files: fstest.MapFS{
+				"migrations/1_abcdef.up.sql": {},
+			},
+			opts:    migrationOptions{MaxDescriptionLen: 5},
+			wantErr: `db migration 1_abcdef.up.sql: description is too long \(6 characters, the maximum is 5\)`,
+		},
+		{
+			name: "ignore_patterns",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql":          {},
+				"migrations/2_bar.up.sql.disabled": {},
+				"migrations/2_bar.up.sql":          {Data: []byte("SELECT 1;")},
+				"migrations/.#2_bar.up.sql":        {},
+				"migrations/3_baz.up.sql":          {},
+			},
+			opts: migrationOptions{Ignore: []string{"*.disabled", ".#*", "3_*"}},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo", Checksum: emptyChecksum},
+				{Filename: "2_bar.up.sql", Number: 2, Description: "bar", Checksum: Checksum([]byte("SELECT 1;")), StatementCount: 1},
+			},
+		},
+		{
+			name: "orphaned_down",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql":     {},
+				"migrations/1_foo.down.sql":   {},
+				"migrations/2_bar.down.sql":   {},
+				"migrations/2_baz.up.sql.bak": {},
+			},
+			wantErr: `db migration 2_bar.down.sql: down migration has no corresponding up migration \(missing up migration number 2\)`,
+		},
+		{
+			name: "orphaned_down_ignored",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql":   {},
+				"migrations/2_bar.down.sql": {},
+			},
+			opts: migrationOptions{IgnoreDowns: true},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo", Checksum: emptyChecksum},
+			},
+		},
+		{
+			name: "invalid_name",
+			files: fstest.MapFS{
+				"migrations/foo.up.sql": {},
+			},
+			wantErr: `db migration foo.up.sql: invalid name.*`,
+		},
+		{
+			name: "duplicate_number",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {},
+				"migrations/1_bar.up.sql": {},
+			},
+			wantErr: `db migration .*: duplicate migration with number 1`,
+		},
+		{
+			name: "case_collision",
+			files: fstest.MapFS{
+				"migrations/1_Foo.up.sql": {},
+				"migrations/1_foo.up.sql": {},
+			},
+			wantErr: `db migration 1_foo.up.sql: filename differs from 1_Foo.up.sql only by case, .*`,
+		},
+		{
+			name: "direction_first",
+			files: fstest.MapFS{
+				"migrations/1_up_foo.sql":   {},
+				"migrations/1_down_foo.sql": {},
+				"migrations/2_up.sql":       {},
+			},
+			opts: migrationOptions{Naming: NamingDirectionFirst},
+			want: []MigrationFile{
+				{Filename: "1_up_foo.sql", Number: 1, Description: "foo", Checksum: emptyChecksum},
+				{Filename: "2_up.sql", Number: 2, Description: "", Checksum: emptyChecksum},
+			},
+		},
+		{
+			name: "direction_first_invalid",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {},
+			},
+			opts:    migrationOptions{Naming: NamingDirectionFirst},
+			wantErr: `db migration 1_foo.up.sql: invalid name \(must be of the format '\[123\]_\[up\|down\]_\[description\].sql'\)`,
+		},
+		{
+			name: "checksum",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql":        {Data: []byte("CREATE TABLE foo ();")},
+				"migrations/1_foo.up.sql.sha256": {Data: []byte(Checksum([]byte("CREATE TABLE foo ();")) + "  1_foo.up.sql\n")},
+			},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo", Checksum: Checksum([]byte("CREATE TABLE foo ();")), StatementCount: 1},
+			},
+		},
+		{
+			name: "checksum_mismatch",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql":        {Data: []byte("CREATE TABLE foo (id INT);")},
+				"migrations/1_foo.up.sql.sha256": {Data: []byte(Checksum([]byte("CREATE TABLE foo ();")))},
+			},
+			wantErr: `db migration 1_foo.up.sql: checksum mismatch .*`,
+		},
+		{
+			name: "environments",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- Test users.\n-- encore:environments local, test\nINSERT INTO users VALUES (1);\n-- encore:environments production\n")},
+			},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo",
+					Checksum:     Checksum([]byte("-- Test users.\n-- encore:environments local, test\nINSERT INTO users VALUES (1);\n-- encore:environments production\n")),
+					Environments: []string{"local", "test"}, StatementCount: 1},
+			},
+		},
+		{
+			name: "environments_invalid",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- encore:environments staging\n")},
+			},
+			wantErr: `db migration 1_foo.up.sql: invalid environment "staging" in encore:environments annotation .*`,
+		},
+		{
+			name: "long_first_line",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("INSERT INTO users VALUES " + strings.Repeat("(1),", 20000) + "(1);\n")},
+			},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo",
+					Checksum:       Checksum([]byte("INSERT INTO users VALUES " + strings.Repeat("(1),", 20000) + "(1);\n")),
+					StatementCount: 1},
+			},
+		},
+		{
+			name: "requires_extension",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- encore:requires-extension pgcrypto, uuid-ossp\n-- encore:requires-extension pgcrypto\n")},
+			},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo",
+					Checksum:           Checksum([]byte("-- encore:requires-extension pgcrypto, uuid-ossp\n-- encore:requires-extension pgcrypto\n")),
+					RequiredExtensions: []string{"pgcrypto", "uuid-ossp"}},
+			},
+		},
+		{
+			name: "requires_extension_invalid",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- encore:requires-extension Bad Name\n")},
+			},
+			wantErr: `db migration 1_foo.up.sql: invalid extension name "Bad Name" in encore:requires-extension annotation`,
+		},
+		{
+			name: "author",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- encore:author Jane Doe <jane@example.com>\n")},
+				"migrations/2_bar.up.sql": {Data: []byte("-- encore:author jdoe\n")},
+			},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo",
+					Checksum: Checksum([]byte("-- encore:author Jane Doe <jane@example.com>\n")),
+					Author:   "Jane Doe <jane@example.com>"},
+				{Filename: "2_bar.up.sql", Number: 2, Description: "bar",
+					Checksum: Checksum([]byte("-- encore:author jdoe\n")),
+					Author:   "jdoe"},
+			},
+		},
+		{
+			name: "author_invalid",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- encore:author jane@\n")},
+			},
+			wantErr: `db migration 1_foo.up.sql: invalid author "jane@" in encore:author annotation .*`,
+		},
+		{
+			name: "conflict_markers",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("CREATE TABLE foo (\n<<<<<<< HEAD\n\tid INT\n=======\n\tid BIGINT\n>>>>>>> feature\n);\n")},
+			},
+			wantErr: `db migration 1_foo.up.sql: unresolved merge conflict: line 2 contains the conflict marker "<<<<<<<"`,
+		},
+		{
+			name: "invalid_utf8",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("SELECT 'caf\xc3';\n")},
+			},
+			wantErr: `db migration 1_foo.up.sql: migration is not valid UTF-8: invalid byte sequence at offset 11`,
+		},
+		{
+			name: "valid_utf8",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("SELECT 'café 日本';\n")},
+			},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo",
+					Checksum:       Checksum([]byte("SELECT 'café 日本';\n")),
+					StatementCount: 1},
+			},
+		},
+		{
+			name: "conflict_marker_lookalikes",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- =======\nSELECT '<<<<<<< x';\n")},
+			},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo",
+					Checksum:       Checksum([]byte("-- =======\nSELECT '<<<<<<< x';\n")),
+					StatementCount: 1},
+			},
+		},
+		{
+			name: "destructive",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- encore:destructive\nDROP TABLE foo;\n")},
+			},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo",
+					Checksum:       Checksum([]byte("-- encore:destructive\nDROP TABLE foo;\n")),
+					StatementCount: 1,
+					Destructive:    true},
+			},
+		},
+		{
+			name: "destructive_with_value",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- encore:destructive yes\n")},
+			},
+			wantErr: `db migration 1_foo.up.sql: the encore:destructive annotation takes no value`,
+		},
+		{
+			name: "pinned_last",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql":   {Data: []byte("")},
+				"migrations/2_bar.up.sql":   {Data: []byte("")},
+				"migrations/3_grant.up.sql": {Data: []byte("-- encore:pinned-last\nGRANT SELECT ON ALL TABLES IN SCHEMA public TO reader;\n")},
+			},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo", Checksum: Checksum(nil)},
+				{Filename: "2_bar.up.sql", Number: 2, Description: "bar", Checksum: Checksum(nil)},
+				{Filename: "3_grant.up.sql", Number: 3, Description: "grant",
+					Checksum:       Checksum([]byte("-- encore:pinned-last\nGRANT SELECT ON ALL TABLES IN SCHEMA public TO reader;\n")),
+					StatementCount: 1,
+					PinnedLast:     true},
+			},
+		},
+		{
+			name: "pinned_last_not_highest",
+			files: fstest.MapFS{
+				"migrations/1_grant.up.sql": {Data: []byte("-- encore:pinned-last\n")},
+				"migrations/2_foo.up.sql":   {Data: []byte("")},
+				"migrations/3_bar.up.sql":   {Data: []byte("")},
+			},
+			wantErr: `db migration 1_grant.up.sql: a migration pinned last must have the highest number, but 3_bar.up.sql comes after it; renumber it so it's greater than 3`,
+		},
+		{
+			name: "multiple_pinned_last",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- encore:pinned-last\n")},
+				"migrations/2_bar.up.sql": {Data: []byte("-- encore:pinned-last\n")},
+			},
+			wantErr: `db migration 2_bar.up.sql: only one migration can be pinned last, but 1_foo.up.sql is also pinned`,
+		},
+		{
+			name: "front_matter",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- ---\n-- tags: [users, seed]\n-- timeout: 5m\n-- no_transaction: true\n-- environments: [local]\n-- ---\nINSERT INTO users VALUES (1);\n")},
+			},
+			want: []MigrationFile{
+				{Filename: "1_foo.up.sql", Number: 1, Description: "foo",
+					Checksum:       Checksum([]byte("-- ---\n-- tags: [users, seed]\n-- timeout: 5m\n-- no_transaction: true\n-- environments: [local]\n-- ---\nINSERT INTO users VALUES (1);\n")),
+					Environments:   []string{"local"},
+					StatementCount: 1,
+					Meta: map[string]any{
+						"tags":           []string{"users", "seed"},
+						"timeout":        "5m",
+						"no_transaction": true,
+						"environments":   []string{"local"},
+					}},
+			},
+		},
+		{
+			name: "front_matter_unknown_key",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- ---\n-- retries: 3\n-- ---\n")},
+			},
+			wantErr: `db migration 1_foo.up.sql: unknown front-matter key "retries"`,
+		},
+		{
+			name: "front_matter_unterminated",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- ---\n-- timeout: 5m\nSELECT 1;\n")},
+			},
+			wantErr: `db migration 1_foo.up.sql: unterminated front-matter .*`,
+		},
+		{
+			name: "front_matter_environments_conflict",
+			files: fstest.MapFS{
+				"migrations/1_foo.up.sql": {Data: []byte("-- ---\n-- environments: [test]\n-- ---\n-- encore:environments local\n")},
+			},
+			wantErr: `db migration 1_foo.up.sql: environments declared both in front-matter and .*`,
+		},
+		{
+			name:    "missing_dir",
+			files:   fstest.MapFS{},
+			wantErr: `could not read migrations: .*`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := qt.New(t)
+			got, err := parseMigrations(test.files, "migrations", test.opts)
+			if test.wantErr != "" {
+				c.Assert(err, qt.ErrorMatches, test.wantErr)
+				return
+			}
+			c.Assert(err, qt.IsNil)
+			c.Assert(got, qt.DeepEquals, test.want)
+		})
+	}
+}
+
+func TestApplyTransforms(t *testing.T) {
+	c := qt.New(t)
+	orig := transforms
+	t.Cleanup(func() { transforms = orig })
+
+	migrations := []MigrationFile{
+		{Filename: "1_foo.up.sql", Number: 1, Description: "foo"},
+		{Filename: "2_test_data.up.sql", Number: 2, Description: "test_data"},
+		{Filename: "3_bar.up.sql", Number: 3, Description: "bar"},
+	}
+
+	// Filtering out migrations is allowed.
+	transforms = nil
+	RegisterMigrationTransform(func(dbName string, migrations []MigrationFile) ([]MigrationFile, error) {
+		return slices.DeleteFunc(slices.Clone(migrations), func(m MigrationFile) bool {
+			return m.Description == "test_data"
+		}), nil
+	})
+	got, err := applyTransforms("db", migrations)
+	c.Assert(err, qt.IsNil)
+	c.Assert(got, qt.DeepEquals, []MigrationFile{migrations[0], migrations[2]})
+
+	// Reordering migrations is not.
+	transforms = nil
+	RegisterMigrationTransform(func(dbName string, migrations []MigrationFile) ([]MigrationFile, error) {
+		return []MigrationFile{migrations[1], migrations[0]}, nil
+	})
+	_, err = applyTransforms("db", migrations)
+	c.Assert(err, qt.ErrorMatches, `migration transform #1 returned invalid migrations: db migration 1_foo.up.sql: migrations are not ordered by number`)
+}
+
+func TestCheckPostgresVersion(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{
+		"migrations/1_foo.up.sql": {Data: []byte("CREATE TABLE foo (id BIGINT GENERATED ALWAYS AS IDENTITY);")},
+		"migrations/2_bar.up.sql": {Data: []byte("CREATE UNIQUE INDEX bar_idx ON foo (a) NULLS NOT DISTINCT;")},
+	}
+	migrations, err := parseMigrations(fsys, "migrations", migrationOptions{})
+	c.Assert(err, qt.IsNil)
+
+	issues, err := checkPostgresVersion(fsys, "migrations", migrations, 12)
+	c.Assert(err, qt.IsNil)
+	c.Assert(issues, qt.HasLen, 1)
+	c.Assert(issues[0].String(), qt.Equals, "db migration 2_bar.up.sql: uses NULLS NOT DISTINCT constraints, which requires Postgres 15 or later")
+
+	issues, err = checkPostgresVersion(fsys, "migrations", migrations, 9)
+	c.Assert(err, qt.IsNil)
+	c.Assert(issues, qt.HasLen, 2)
+
+	issues, err = checkPostgresVersion(fsys, "migrations", migrations, 16)
+	c.Assert(err, qt.IsNil)
+	c.Assert(issues, qt.HasLen, 0)
+}
+
+func TestCheckTransactionControl(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{
+		"migrations/1_foo.up.sql": {Data: []byte("BEGIN;\nCREATE TABLE foo ();\n-- done\ncommit;\n")},
+		"migrations/2_bar.up.sql": {Data: []byte(`CREATE FUNCTION bar() RETURNS void AS $$
+BEGIN
+	RETURN;
+END;
+$$ LANGUAGE plpgsql;
+/* not a BEGIN; */ SELECT 'COMMIT;';
+`)},
+		"migrations/3_baz.up.sql": {Data: []byte("-- ---\n-- no_transaction: true\n-- ---\nSTART TRANSACTION;\nCOMMIT;\n")},
+	}
+	migrations, err := parseMigrations(fsys, "migrations", migrationOptions{})
+	c.Assert(err, qt.IsNil)
+
+	issues, err := checkMigrationContents(fsys, "migrations", migrations, []ContentCheck{CheckTransactions})
+	c.Assert(err, qt.IsNil)
+	c.Assert(issues, qt.HasLen, 2)
+	c.Assert(issues[0].Filename, qt.Equals, "1_foo.up.sql")
+	c.Assert(issues[0].Line, qt.Equals, 1)
+	c.Assert(issues[1].Line, qt.Equals, 4)
+	c.Assert(issues[1].String(), qt.Matches, `db migration 1_foo.up.sql:4: contains the transaction control statement COMMIT, .*`)
+}
+
+func TestCheckSchemaReferences(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{
+		"migrations/1_foo.up.sql": {Data: []byte(`CREATE TABLE foo (
+	id BIGINT PRIMARY KEY,
+	bar_id BIGINT REFERENCES billing.bars (id)
+);
+INSERT INTO foo SELECT id, id FROM otherdb.public.items;
+-- SELECT * FROM commented.out;
+SELECT 'FROM quoted.table', EXTRACT(EPOCH FROM f.created_at) FROM public.foo f;
+`)},
+	}
+	migrations, err := parseMigrations(fsys, "migrations", migrationOptions{})
+	c.Assert(err, qt.IsNil)
+
+	issues, err := checkMigrationContents(fsys, "migrations", migrations, []ContentCheck{CheckSchemas})
+	c.Assert(err, qt.IsNil)
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.String())
+	}
+	c.Assert(got, qt.DeepEquals, []string{
+		"db migration 1_foo.up.sql:3: references billing.bars in the hardcoded schema billing",
+		"db migration 1_foo.up.sql:5: references otherdb.public.items, which looks like a table in another database",
+	})
+}
+
+func TestCheckDestructive(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{
+		"migrations/1_foo.up.sql": {Data: []byte(`ALTER TABLE foo DROP CONSTRAINT foo_pkey;
+ALTER TABLE foo ALTER COLUMN bar DROP NOT NULL;
+-- DROP TABLE commented_out;
+ALTER TABLE foo DROP baz, DROP COLUMN IF EXISTS qux;
+DROP TABLE bar;
+`)},
+		"migrations/2_bar.up.sql": {Data: []byte("-- encore:destructive\nTRUNCATE bar;\n")},
+		"migrations/3_baz.up.sql": {Data: []byte("-- encore:author jdoe\n-- encore:destructive\nDROP INDEX baz_idx;\n")},
+	}
+	migrations, err := parseMigrations(fsys, "migrations", migrationOptions{})
+	c.Assert(err, qt.IsNil)
+
+	issues, err := checkMigrationContents(fsys, "migrations", migrations, []ContentCheck{CheckDestructive})
+	c.Assert(err, qt.IsNil)
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.String())
+	}
+	const hint = ` (add a "-- encore:destructive" annotation to its leading comments)`
+	c.Assert(got, qt.DeepEquals, []string{
+		"db migration 1_foo.up.sql:4: contains the destructive statement DROP COLUMN, but isn't annotated as destructive" + hint,
+		"db migration 1_foo.up.sql:4: contains the destructive statement DROP COLUMN, but isn't annotated as destructive" + hint,
+		"db migration 1_foo.up.sql:5: contains the destructive statement DROP TABLE, but isn't annotated as destructive" + hint,
+		"db migration 3_baz.up.sql:2: is annotated with encore:destructive, but contains no destructive statements",
+	})
+}
+
+func TestCheckReversible(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{
+		"migrations/1_foo.up.sql": {Data: []byte(`CREATE TABLE users (id INT);
+CREATE TABLE IF NOT EXISTS public.accounts (id INT);
+CREATE TEMP TABLE scratch (id INT);
+CREATE TABLE "Orders" (id INT);
+CREATE TABLE items (id INT);
+`)},
+		"migrations/1_foo.down.sql": {Data: []byte(`DROP TABLE IF EXISTS accounts, Users;
+-- DROP TABLE items;
+DROP TABLE orders;
+`)},
+		"migrations/2_bar.up.sql": {Data: []byte(`CREATE TABLE no_down (id INT);`)},
+	}
+	migrations, err := parseMigrations(fsys, "migrations", migrationOptions{})
+	c.Assert(err, qt.IsNil)
+
+	issues, err := checkReversible(fsys, "migrations", migrationOptions{}, migrations)
+	c.Assert(err, qt.IsNil)
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.String())
+	}
+	c.Assert(got, qt.DeepEquals, []string{
+		`db migration 1_foo.up.sql:4: creates the table "Orders", but its down migration 1_foo.down.sql doesn't drop it`,
+		`db migration 1_foo.up.sql:5: creates the table items, but its down migration 1_foo.down.sql doesn't drop it`,
+	})
+
+	issues, err = checkReversible(fsys, "migrations", migrationOptions{IgnoreDowns: true}, migrations)
+	c.Assert(err, qt.IsNil)
+	c.Assert(issues, qt.HasLen, 0)
+}
+
+func TestCheckIdempotent(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{
+		"migrations/1_foo.up.sql": {Data: []byte(`CREATE TABLE users (id INT);
+CREATE TABLE IF NOT EXISTS accounts (id INT);
+CREATE UNIQUE INDEX users_id_idx ON users (id);
+CREATE INDEX ON users (id);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS users_idx ON users (id);
+CREATE OR REPLACE FUNCTION f() RETURNS INT AS $$ SELECT 1 $$ LANGUAGE sql;
+CREATE VIEW active_users AS SELECT * FROM users;
+-- CREATE TABLE commented_out (id INT);
+CREATE TYPE mood AS ENUM ('happy');
+CREATE SCHEMA billing;
+`)},
+	}
+	migrations, err := parseMigrations(fsys, "migrations", migrationOptions{})
+	c.Assert(err, qt.IsNil)
+
+	issues, err := checkMigrationContents(fsys, "migrations", migrations, []ContentCheck{CheckIdempotent})
+	c.Assert(err, qt.IsNil)
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.String())
+	}
+	const suffix = ", which fails if the migration is re-run against a database where the object already exists"
+	c.Assert(got, qt.DeepEquals, []string{
+		"db migration 1_foo.up.sql:1: contains CREATE TABLE users without IF NOT EXISTS" + suffix,
+		"db migration 1_foo.up.sql:3: contains CREATE INDEX users_id_idx without IF NOT EXISTS" + suffix,
+		"db migration 1_foo.up.sql:7: contains CREATE VIEW active_users without OR REPLACE" + suffix,
+		"db migration 1_foo.up.sql:10: contains CREATE SCHEMA billing without IF NOT EXISTS" + suffix,
+	})
+}
+
+func TestExecutableMigrations(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{
+		"migrations/1_foo.up.sql": {Mode: 0o644},
+		"migrations/2_bar.up.sql": {Mode: 0o755},
+		"migrations/3_baz.up.sql": {Mode: 0o640},
+	}
+	migrations, err := parseMigrations(fsys, "migrations", migrationOptions{})
+	c.Assert(err, qt.IsNil)
+
+	executable, err := executableMigrations(fsys, "migrations", migrations)
+	c.Assert(err, qt.IsNil)
+	c.Assert(executable, qt.DeepEquals, []string{"2_bar.up.sql"})
+}
+
+func TestMismatchedDowns(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{
+		"migrations/1_add_users.up.sql":   {},
+		"migrations/1_add_user.down.sql":  {},
+		"migrations/2_add_email.up.sql":   {},
+		"migrations/2_add_email.down.sql": {},
+		"migrations/3_orphan.down.sql":    {},
+	}
+	ups := []MigrationFile{
+		{Filename: "1_add_users.up.sql", Number: 1, Description: "add_users"},
+		{Filename: "2_add_email.up.sql", Number: 2, Description: "add_email"},
+	}
+	got, err := mismatchedDowns(fsys, "migrations", migrationOptions{}, ups)
+	c.Assert(err, qt.IsNil)
+	c.Assert(got, qt.DeepEquals, [][2]string{{"1_add_users.up.sql", "1_add_user.down.sql"}})
+
+	got, err = mismatchedDowns(fsys, "migrations", migrationOptions{IgnoreDowns: true}, ups)
+	c.Assert(err, qt.IsNil)
+	c.Assert(got, qt.HasLen, 0)
+}
+
+func TestUntrackedMigrations(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	c := qt.New(t)
+	root := t.TempDir()
+	git := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = root
+		out, err := cmd.CombinedOutput()
+		c.Assert(err, qt.IsNil, qt.Commentf("git %s: %s", strings.Join(args, " "), out))
+	}
+	migDir := filepath.Join(root, "migrations")
+	c.Assert(os.MkdirAll(filepath.Join(migDir, "sub"), 0755), qt.IsNil)
+	for _, name := range []string{"1_foo.up.sql", "2_bar.up.sql", "3_baz.up.sql", "notes.txt", "sub/4_qux.up.sql", "sub/5_quux.up.sql"} {
+		c.Assert(os.WriteFile(filepath.Join(migDir, filepath.FromSlash(name)), nil, 0644), qt.IsNil)
+	}
+	c.Assert(os.WriteFile(filepath.Join(root, ".gitignore"), []byte("3_baz.up.sql\n"), 0644), qt.IsNil)
+	git("init", "-q")
+	git("add", "migrations/1_foo.up.sql", "migrations/sub/5_quux.up.sql")
+
+	// Only the parsed migrations are considered, so the untracked
+	// file in the subdirectory is only reported when it's a migration.
+	migrations := []MigrationFile{{Filename: "1_foo.up.sql"}, {Filename: "2_bar.up.sql"}, {Filename: "3_baz.up.sql"}}
+	got, err := untrackedMigrations(migDir, migrations)
+	c.Assert(err, qt.IsNil)
+	c.Assert(got, qt.DeepEquals, []string{"2_bar.up.sql", "3_baz.up.sql"})
+
+	migrations = append(migrations, MigrationFile{Filename: "sub/4_qux.up.sql"}, MigrationFile{Filename: "sub/5_quux.up.sql"})
+	got, err = untrackedMigrations(migDir, migrations)
+	c.Assert(err, qt.IsNil)
+	c.Assert(got, qt.DeepEquals, []string{"2_bar.up.sql", "3_baz.up.sql", "sub/4_qux.up.sql"})
+}
+
+func TestGitIgnoredMigrations(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	c := qt.New(t)
+	root := t.TempDir()
+	cmd := exec.Command("git", "init", "-q")
+	cmd.Dir = root
+	out, err := cmd.CombinedOutput()
+	c.Assert(err, qt.IsNil, qt.Commentf("git init: %s", out))
+
+	migDir := filepath.Join(root, "migrations")
+	c.Assert(os.MkdirAll(migDir, 0755), qt.IsNil)
+	var migrations []MigrationFile
+	for _, name := range []string{"1_foo.up.sql", "2_bar.up.sql", "3_baz.up.sql"} {
+		c.Assert(os.WriteFile(filepath.Join(migDir, name), nil, 0644), qt.IsNil)
+		migrations = append(migrations, MigrationFile{Filename: name})
+	}
+
+	got, err := gitIgnoredMigrations(migDir, migrations)
+	c.Assert(err, qt.IsNil)
+	c.Assert(got, qt.HasLen, 0)
+
+	c.Assert(os.WriteFile(filepath.Join(root, ".gitignore"), []byte("*.sql\n!2_bar.up.sql\n"), 0644), qt.IsNil)
+	got, err = gitIgnoredMigrations(migDir, migrations)
+	c.Assert(err, qt.IsNil)
+	c.Assert(got, qt.DeepEquals, []gitIgnoredMigration{
+		{Filename: "1_foo.up.sql", Source: ".gitignore:1", Pattern: "*.sql"},
+		{Filename: "3_baz.up.sql", Source: ".gitignore:1", Pattern: "*.sql"},
+	})
+
+	c.Assert(os.WriteFile(filepath.Join(root, ".gitignore"), []byte("migrations/\n"), 0644), qt.IsNil)
+	got, err = gitIgnoredMigrations(migDir, migrations)
+	c.Assert(err, qt.IsNil)
+	c.Assert(got, qt.HasLen, 3)
+	c.Assert(got[0], qt.Equals, gitIgnoredMigration{Filename: "1_foo.up.sql", Source: ".gitignore:1", Pattern: "migrations/"})
+}
+
+func TestNumericDescriptions(t *testing.T) {
+	c := qt.New(t)
+	migrations := []MigrationFile{
+		{Filename: "1_2.up.sql", Number: 1, Description: "2"},
+		{Filename: "2_add_users.up.sql", Number: 2, Description: "add_users"},
+		{Filename: "3_2024_01.up.sql", Number: 3, Description: "2024_01"},
+		{Filename: "4_v2.up.sql", Number: 4, Description: "v2"},
+		{Filename: "5.up.sql", Number: 5, Description: ""},
+		{Filename: "6___.up.sql", Number: 6, Description: "__"},
+	}
+	var got []string
+	for _, m := range numericDescriptions(migrations) {
+		got = append(got, m.Filename)
+	}
+	c.Assert(got, qt.DeepEquals, []string{"1_2.up.sql", "3_2024_01.up.sql"})
+}
+
+func TestIdenticalMigrations(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{
+		"migrations/1_foo.up.sql":   {Data: []byte("CREATE TABLE foo ();")},
+		"migrations/2_bar.up.sql":   {Data: []byte("CREATE TABLE bar ();")},
+		"migrations/3_foo2.up.sql":  {Data: []byte("CREATE TABLE foo ();")},
+		"migrations/4_empty.up.sql": {},
+		"migrations/5_empty.up.sql": {},
+	}
+	migrations, err := parseMigrations(fsys, "migrations", migrationOptions{})
+	c.Assert(err, qt.IsNil)
+	c.Assert(identicalMigrations(migrations), qt.DeepEquals, [][2]MigrationFile{
+		{migrations[0], migrations[2]},
+	})
+}
+
+func TestMigrationError(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{
+		"migrations/1_foo.up.sql": {},
+		"migrations/1_bar.up.sql": {},
+	}
+	_, err := parseMigrations(fsys, "migrations", migrationOptions{})
+	err = withDatabase(fmt.Errorf("parsing: %w", err), "db")
+
+	var migErr *MigrationError
+	c.Assert(errors.As(err, &migErr), qt.IsTrue)
+	c.Assert(migErr.Database, qt.Equals, "db")
+	c.Assert(migErr.Filename, qt.Equals, "1_foo.up.sql")
+	c.Assert(migErr.Kind, qt.Equals, MigrationErrDuplicate)
+	c.Assert(migErr.Actual, qt.Equals, uint64(1))
+}
+
+func TestGroupByPrefix(t *testing.T) {
+	c := qt.New(t)
+	var (
+		m1 = MigrationFile{Filename: "1_users_create.up.sql", Number: 1, Description: "users_create"}
+		m2 = MigrationFile{Filename: "2_billing_init.up.sql", Number: 2, Description: "billing_init"}
+		m3 = MigrationFile{Filename: "3_users_add_email.up.sql", Number: 3, Description: "users_add_email"}
+		m4 = MigrationFile{Filename: "4_cleanup.up.sql", Number: 4, Description: "cleanup"}
+		m5 = MigrationFile{Filename: "5.up.sql", Number: 5}
+	)
+	got := GroupByPrefix([]MigrationFile{m1, m2, m3, m4, m5}, "_")
+	c.Assert(got, qt.DeepEquals, map[string][]MigrationFile{
+		"users":   {m1, m3},
+		"billing": {m2},
+		"cleanup": {m4},
+		"":        {m5},
+	})
+}
+
+func TestFindByDescription(t *testing.T) {
+	c := qt.New(t)
+	var (
+		m1 = MigrationFile{Filename: "1_add_users_table.up.sql", Number: 1, Description: "add_users_table"}
+		m2 = MigrationFile{Filename: "2_seed.up.sql", Number: 2, Description: "seed"}
+		m3 = MigrationFile{Filename: "3_seed.up.sql", Number: 3, Description: "seed"}
+	)
+	migrations := []MigrationFile{m1, m2, m3}
+
+	got, ok := FindByDescription(migrations, "add users table")
+	c.Assert(ok, qt.IsTrue)
+	c.Assert(got, qt.DeepEquals, m1)
+
+	got, ok = FindByDescription(migrations, "SEED")
+	c.Assert(ok, qt.IsTrue)
+	c.Assert(got, qt.DeepEquals, m2)
+
+	_, ok = FindByDescription(migrations, "add_users")
+	c.Assert(ok, qt.IsFalse)
+}
+
+func TestAppMigrationOverview(t *testing.T) {
+	c := qt.New(t)
+	dbs := []*Database{
+		{
+			Name:         "users",
+			MigrationDir: "users/migrations",
+			Migrations:   []MigrationFile{{Number: 1}, {Number: 3}},
+			Baseline:     1,
+		},
+		{
+			Name:         "billing",
+			MigrationDir: "billing/migrations",
+			DependsOn:    []string{"users"},
+		},
+	}
+	c.Assert(AppMigrationOverview(dbs), qt.DeepEquals, &MigrationOverview{
+		Databases: []*DatabaseOverview{
+			{Name: "billing", MigrationDir: "billing/migrations", DependsOn: []string{"users"}},
+			{Name: "users", MigrationDir: "users/migrations", MigrationCount: 2, LatestMigration: 3, Baseline: 1},
+		},
+	})
+}
+
+func TestMigrationSARIF(t *testing.T) {
+	c := qt.New(t)
+	dbs := []*Database{{Name: "users", MigrationDir: "users/migrations"}}
+	errs := []error{
+		fmt.Errorf("parsing: %w", &MigrationError{Database: "users", Filename: "2_foo.up.sql", Kind: MigrationErrDuplicate, Detail: "duplicate migration with number 2"}),
+		&MigrationError{Database: "users", Kind: MigrationErrRead, Detail: "could not read migrations"},
+		errors.New("database users: defined more than once"),
+	}
+	data, err := MigrationSARIF(dbs, errs)
+	c.Assert(err, qt.IsNil)
+
+	var log struct {
+		Version string
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Rules []struct{ ID string }
+				}
+			}
+			Results []struct {
+				RuleID    string
+				Message   struct{ Text string }
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct{ URI string }
+						Region           *struct{ StartLine int }
+					}
+				}
+			}
+		}
+	}
+	c.Assert(json.Unmarshal(data, &log), qt.IsNil)
+	c.Assert(log.Version, qt.Equals, "2.1.0")
+	c.Assert(log.Runs, qt.HasLen, 1)
+	c.Assert(log.Runs[0].Tool.Driver.Rules, qt.HasLen, 2)
+
+	results := log.Runs[0].Results
+	c.Assert(results, qt.HasLen, 2)
+	c.Assert(results[0].RuleID, qt.Equals, "sqldb/duplicate")
+	c.Assert(results[0].Message.Text, qt.Equals, "db migration 2_foo.up.sql: duplicate migration with number 2")
+	c.Assert(results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI, qt.Equals, "users/migrations/2_foo.up.sql")
+	c.Assert(results[0].Locations[0].PhysicalLocation.Region.StartLine, qt.Equals, 1)
+	c.Assert(results[1].RuleID, qt.Equals, "sqldb/read")
+	c.Assert(results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI, qt.Equals, "users/migrations")
+	c.Assert(results[1].Locations[0].PhysicalLocation.Region, qt.IsNil)
+}
+
+func TestParseMigrationName(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    MigrationFile
+		wantErr string
+	}{
+		{name: "1_foo.up.sql", want: MigrationFile{Filename: "1_foo.up.sql", Number: 1, Description: "foo"}},
+		{name: "20_foo_bar.down.sql", want: MigrationFile{Filename: "20_foo_bar.down.sql", Number: 20, Description: "foo_bar"}},
+		{name: "3.up.sql", want: MigrationFile{Filename: "3.up.sql", Number: 3}},
+		{name: "foo.up.sql", wantErr: `db migration foo.up.sql: invalid name .*`},
+		{name: "1_foo.sql", wantErr: `db migration 1_foo.sql: invalid name .*`},
+		{name: "99999999999999999999_foo.up.sql", wantErr: `db migration .*: invalid version number "99999999999999999999" \(could not be parsed as an integer: value out of range\)`},
+		{name: "0_foo.up.sql", wantErr: `db migration 0_foo.up.sql: invalid version number 0 \(must be positive, not zero\)`},
+		{name: "000_foo.up.sql", wantErr: `db migration 000_foo.up.sql: invalid version number 0 \(must be positive, not zero\)`},
+		{name: "-1_foo.up.sql", wantErr: `db migration -1_foo.up.sql: invalid version number \(must be positive, not negative\)`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := qt.New(t)
+			got, err := ParseMigrationName(test.name)
+			if test.wantErr != "" {
+				c.Assert(err, qt.ErrorMatches, test.wantErr)
+				return
+			}
+			c.Assert(err, qt.IsNil)
+			c.Assert(got, qt.DeepEquals, test.want)
+		})
+	}
+}
+
+// permissionFS is an fs.FS that denies all access.
+type permissionFS struct{}
+
+func (permissionFS) Open(name string) (fs.File, error) {
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrPermission}
+}
+
+func TestPermissionError(t *testing.T) {
+	c := qt.New(t)
+	_, err := parseMigrations(permissionFS{}, ".", migrationOptions{})
+	err = describePermissionError(err, "/app/foo/migrations")
+	c.Assert(err, qt.ErrorMatches, `permission denied reading /app/foo/migrations \(check that it's readable by the user running Encore\)`)
+	c.Assert(errors.Is(err, fs.ErrPermission), qt.IsTrue)
+}
+
+func TestMigrationDirs(t *testing.T) {
+	c := qt.New(t)
+	root := t.TempDir()
+	c.Assert(os.MkdirAll(filepath.Join(root, "svc", "sub"), 0755), qt.IsNil)
+
+	svc := &pkginfo.Package{FSPath: paths.RootedFSPath(root, "svc")}
+	sub := &pkginfo.Package{FSPath: paths.RootedFSPath(root, "svc/sub")}
+	dbs := []*Database{
+		{Name: "users", Pkg: svc, MigrationDir: "svc/migrations"},
+		{Name: "shared", Pkg: sub, MigrationDir: "shared/migrations"},
+		{Name: "users2", Pkg: sub, MigrationDir: "svc/migrations"},
+		{Name: "nopkg", MigrationDir: "other/migrations"},
+	}
+	c.Assert(MigrationDirs(paths.RootedFSPath(root, "."), dbs), qt.DeepEquals, []paths.FS{
+		paths.RootedFSPath(root, "svc/migrations"),
+		paths.RootedFSPath(root, "shared/migrations"),
+	})
+}
+
+type fakeGraph struct {
+	resources []resource.Resource
+	binds     map[resource.Resource][]resource.Bind
+	usages    map[resource.Resource][]usage.Usage
+}
+
+func (g *fakeGraph) Resources() []resource.Resource              { return g.resources }
+func (g *fakeGraph) Binds(res resource.Resource) []resource.Bind { return g.binds[res] }
+func (g *fakeGraph) Usages(res resource.Resource) []usage.Usage  { return g.usages[res] }
+
+func TestDatabaseReferences(t *testing.T) {
+	c := qt.New(t)
+	svc := &pkginfo.Package{ImportPath: "example.com/svc"}
+	other := &pkginfo.Package{ImportPath: "example.com/other"}
+	client := &pkginfo.Package{ImportPath: "example.com/client"}
+
+	users := &Database{Name: "users", Pkg: svc}
+	unused := &Database{Name: "unused", Pkg: svc}
+	g := &fakeGraph{
+		resources: []resource.Resource{users, unused},
+		binds: map[resource.Resource][]resource.Bind{
+			users: {
+				&resource.PkgDeclBind{Resource: resource.ResourceOrPath{Resource: users}, File: &pkginfo.File{Pkg: svc}},
+				&resource.PkgDeclBind{Resource: resource.ResourceOrPath{Resource: users}, File: &pkginfo.File{Pkg: other}},
+			},
+		},
+		usages: map[resource.Resource][]usage.Usage{
+			users: {
+				&DatabaseUsage{Base: usage.Base{File: &pkginfo.File{Pkg: client}}},
+				&DatabaseUsage{Base: usage.Base{File: &pkginfo.File{Pkg: other}}},
+			},
+		},
+	}
+	c.Assert(DatabaseReferences(g), qt.DeepEquals, map[*Database][]paths.Pkg{
+		users:  {"example.com/client", "example.com/other", "example.com/svc"},
+		unused: {"example.com/svc"},
+	})
+}
+
+func TestValidateAll(t *testing.T) {
+	c := qt.New(t)
+	root := t.TempDir()
+	c.Assert(os.MkdirAll(filepath.Join(root, "svc", "migrations"), 0755), qt.IsNil)
+	c.Assert(os.WriteFile(filepath.Join(root, "svc", "migrations", "1_foo.up.sql"), nil, 0644), qt.IsNil)
+	c.Assert(os.WriteFile(filepath.Join(root, "svc", "migrations", "1_bar.up.sql"), nil, 0644), qt.IsNil)
+	svc := &pkginfo.Package{FSPath: paths.RootedFSPath(root, "svc")}
+
+	dbs := []*Database{
+		{Name: "svc", Pkg: svc, MigrationDir: "svc/migrations"},
+		{Name: "a", DependsOn: []string{"b"}},
+		{Name: "b", DependsOn: []string{"c", "missing"}},
+		{Name: "c", DependsOn: []string{"a"}},
+		{Name: "A"},
+		{Name: "c"},
+		{Name: "self", DependsOn: []string{"self"}},
+	}
+	var got []string
+	for _, err := range ValidateAll(paths.RootedFSPath(root, "."), dbs) {
+		got = append(got, err.Error())
+	}
+	c.Assert(got, qt.DeepEquals, []string{
+		"parsing db migrations for database svc: db migration 1_foo.up.sql: duplicate migration with number 1",
+		"database A: Multiple databases with the same name were found. Database names must be unique.",
+		"database c: Multiple databases with the same name were found. Database names must be unique.",
+		`database b: No database named "missing" was found in the application. Ensure it is created somewhere using sqldb.NewDatabase to be able to reference it.`,
+		"database a: dependency cycle: a -> b -> c -> a",
+		"database self: dependency cycle: self -> self",
+	})
+
+	c.Assert(ValidateAll(paths.RootedFSPath(root, "."), []*Database{{Name: "a"}, {Name: "b", DependsOn: []string{"a"}}}), qt.IsNil)
+}
+
+func TestReparseMigrations(t *testing.T) {
+	c := qt.New(t)
+	root := t.TempDir()
+	migDir := filepath.Join(root, "foo", "migrations")
+	c.Assert(os.MkdirAll(migDir, 0755), qt.IsNil)
+	c.Assert(os.WriteFile(filepath.Join(migDir, "1_foo.up.sql"), nil, 0644), qt.IsNil)
+
+	db := &Database{Name: "foo", MigrationDir: "foo/migrations", RequiredMigrations: []string{"foo"}}
+	c.Assert(os.WriteFile(filepath.Join(migDir, "2_bar.up.sql"), nil, 0644), qt.IsNil)
+	got, err := ReparseMigrations(paths.RootedFSPath(root, "."), db)
+	c.Assert(err, qt.IsNil)
+	c.Assert(got.Migrations, qt.HasLen, 2)
+	c.Assert(db.Migrations, qt.HasLen, 0) // the original is left untouched
+
+	c.Assert(os.Remove(filepath.Join(migDir, "1_foo.up.sql")), qt.IsNil)
+	_, err = ReparseMigrations(paths.RootedFSPath(root, "."), db)
+	c.Assert(err, qt.ErrorMatches, `database foo: requires a migration with the description "foo", but none was found`)
+}
+
+func TestStreamMigrations(t *testing.T) {
+	c := qt.New(t)
+	useMigrationFS(t, fstest.MapFS{
+		"1_foo.up.sql":   {},
+		"2_bad-name.sql": {},
+		"3_bar.up.sql":   {Data: []byte("<<<<<<< HEAD\n")},
+		"4_baz.up.sql":   {},
+	})
+	db := &Database{Name: "foo", MigrationDir: "foo/migrations"}
+
+	var got []string
+	_, err := StreamMigrations("/root", db, func(err *MigrationError) {
+		got = append(got, fmt.Sprintf("%s %s %s", err.Database, err.Filename, err.Kind))
+	})
+	c.Assert(got, qt.DeepEquals, []string{
+		"foo 2_bad-name.sql invalid-name",
+		"foo 3_bar.up.sql conflict-markers",
+	})
+	var merr *MigrationError
+	c.Assert(errors.As(err, &merr), qt.IsTrue)
+	c.Assert(merr.Filename, qt.Equals, "2_bad-name.sql")
+
+	// Problems found after parsing the files are reported too.
+	useMigrationFS(t, fstest.MapFS{"1_foo.up.sql": {}, "1_bar.up.sql": {}})
+	got = nil
+	_, err = StreamMigrations("/root", db, func(err *MigrationError) {
+		got = append(got, fmt.Sprintf("%s %s %s", err.Database, err.Filename, err.Kind))
+	})
+	c.Assert(err, qt.IsNotNil)
+	c.Assert(got, qt.HasLen, 1)
+	c.Assert(got[0], qt.Matches, `foo \S+ duplicate`)
+}
+
+// useMigrationFS makes the parser read migrations from fsys
+// for the duration of the test, regardless of the migration directory.
+func useMigrationFS(t *testing.T, fsys fs.FS) {
+	orig := migrationFS
+	migrationFS = func(paths.FS) fs.FS { return fsys }
+	t.Cleanup(func() { migrationFS = orig })
+}
+
+func TestLintMigrationDir(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{"1_foo.up.sql": {}}
+	useMigrationFS(t, fsys)
+	c.Assert(LintMigrationDir("foo", "/migrations"), qt.IsNil)
+
+	fsys["1_bar.up.sql"] = &fstest.MapFile{}
+	err := LintMigrationDir("foo", "/migrations")
+	var merr *MigrationError
+	c.Assert(errors.As(err, &merr), qt.IsTrue)
+	c.Assert(merr.Database, qt.Equals, "foo")
+	c.Assert(merr.Kind, qt.Equals, MigrationErrDuplicate)
+}
+
+func TestRenderPlan(t *testing.T) {
+	c := qt.New(t)
+	sum := Checksum([]byte("CREATE TABLE foo ();"))
+	db := &Database{
+		Name:     "foo",
+		Baseline: 1,
+		Migrations: []MigrationFile{
+			{Filename: "1_init.up.sql", Number: 1, Description: "init", Checksum: sum},
+			{Filename: "2.up.sql", Number: 2},
+			{Filename: "10_seed.up.sql", Number: 10, Description: "seed", Environments: []string{"local", "test"}, Checksum: sum},
+		},
+	}
+	c.Assert(db.RenderPlan(), qt.Equals, `Database foo: 3 migrations
+   1  init              [baseline]
+   2  (no description)
+  10  seed              [environments: local, test; ! identical to 1_init.up.sql]
+`)
+
+	c.Assert((&Database{Name: "bar"}).RenderPlan(), qt.Equals, "Database bar: no migrations\n")
+}
+
+func TestChangelog(t *testing.T) {
+	c := qt.New(t)
+	old := []MigrationFile{
+		{Filename: "1_create_users.up.sql", Number: 1, Description: "create_users"},
+		{Filename: "2_add_email.up.sql", Number: 2, Description: "add_email"},
+	}
+	c.Assert(Changelog(old, old), qt.Equals, "No new migrations.\n")
+
+	new := append(slices.Clone(old),
+		MigrationFile{Filename: "4_drop_legacy.up.sql", Number: 4, Description: "drop_legacy", Destructive: true},
+		MigrationFile{Filename: "3.up.sql", Number: 3},
+	)
+	c.Assert(Changelog(old, new), qt.Equals, `2 new migrations:
+- 3: (no description)
+- 4: drop_legacy (destructive)
+`)
+	c.Assert(Changelog(nil, old[:1]), qt.Equals, "1 new migration:\n- 1: create_users\n")
+}
+
+func TestSplitStatements(t *testing.T) {
+	fn := `CREATE FUNCTION f() RETURNS int AS $body$
+BEGIN
+	RETURN 1; -- not the end
+END;
+$body$ LANGUAGE plpgsql;`
+	tests := []struct {
+		name string
+		sql  string
+		mode StatementSplitting
+		want int
+	}{
+		{name: "empty", sql: "  \n", want: 0},
+		{name: "simple", sql: "CREATE TABLE foo (); CREATE TABLE bar ();", want: 2},
+		{name: "trailing_text", sql: "CREATE TABLE foo (); SELECT 1", want: 2},
+		{name: "comments", sql: "-- a; b\n/* c; /* nested; */ d; */\nSELECT 1;\n-- done;", want: 1},
+		{name: "strings", sql: `INSERT INTO t VALUES ('a;b', 'it''s;', "x;y", E'\';');`, want: 1},
+		{name: "dollar_quoted", sql: fn + "\nSELECT $1;", want: 2},
+		{name: "dollar_quoted_semicolons", sql: fn, mode: SplitSemicolons, want: 3},
+		{name: "none", sql: fn, mode: SplitNone, want: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := qt.New(t)
+			c.Assert(splitStatements(test.sql, test.mode), qt.HasLen, test.want)
+		})
+	}
+}
+
+func TestNextNumber(t *testing.T) {
+	c := qt.New(t)
+	now := time.Date(2024, 3, 1, 12, 30, 45, 500, time.UTC)
+	existing := []MigrationFile{
+		{Filename: "1_a.up.sql", Number: 1},
+		{Filename: "7_b.up.sql", Number: 7},
+		{Filename: "9999_grant.up.sql", Number: 9999, PinnedLast: true},
+	}
+	c.Assert(SequentialNumbering.NextNumber(nil, now), qt.Equals, uint64(1))
+	c.Assert(SequentialNumbering.NextNumber(existing, now), qt.Equals, uint64(10000))
+
+	c.Assert(TimestampNumbering.NextNumber(nil, now), qt.Equals, uint64(20240301123045))
+	c.Assert(TimestampNumbering.NextNumber([]MigrationFile{{Number: 20240101000000}}, now), qt.Equals, uint64(20240301123045))
+	// The sequence stays increasing if the clock is behind.
+	c.Assert(TimestampNumbering.NextNumber([]MigrationFile{{Number: 20240301123059}}, now), qt.Equals, uint64(20240301123100))
+
+	_, err := SequentialNumbering.CheckNumber("42", 42)
+	c.Assert(err, qt.IsNil)
+	ts, err := TimestampNumbering.CheckNumber("20240301123045", 20240301123045)
+	c.Assert(err, qt.IsNil)
+	c.Assert(ts, qt.Equals, time.Date(2024, 3, 1, 12, 30, 45, 0, time.UTC))
+	_, err = TimestampNumbering.CheckNumber("42", 42)
+	c.Assert(err, qt.ErrorMatches, `invalid version number 42 \(must be a UTC timestamp in the form YYYYMMDDHHMMSS\)`)
+}
+
+func TestMigrationGaps(t *testing.T) {
+	c := qt.New(t)
+	gaps := migrationGaps([]MigrationFile{
+		{Filename: "5_a.up.sql", Number: 5},
+		{Filename: "6_b.up.sql", Number: 6},
+		{Filename: "9_c.up.sql", Number: 9},
+		{Filename: "11_d.up.sql", Number: 11},
+	})
+	c.Assert(gaps, qt.HasLen, 2)
+	c.Assert(gaps[0].Error(), qt.Equals, "db migration 9_c.up.sql: missing migration number 7 (expected after 6_b.up.sql)")
+	c.Assert(gaps[1].Kind, qt.Equals, MigrationErrGap)
+	c.Assert(gaps[1].Expected, qt.Equals, uint64(10))
+	c.Assert(gaps[1].Actual, qt.Equals, uint64(11))
+
+	gaps = migrationGaps([]MigrationFile{
+		{Filename: "1_a.up.sql", Number: 1},
+		{Filename: "1000000_b.up.sql", Number: 1000000},
+	})
+	c.Assert(gaps, qt.HasLen, 1)
+	c.Assert(gaps[0].Error(), qt.Equals, "db migration 1000000_b.up.sql: migration number 1000000 skips 999998 numbers after 1_a.up.sql; the number may be mistyped (expected 2)")
+
+	gaps = migrationGaps([]MigrationFile{
+		{Filename: "1_a.up.sql", Number: 1},
+		{Filename: "2_b.up.sql", Number: 2},
+		{Filename: "9999_grant.up.sql", Number: 9999, PinnedLast: true},
+	})
+	c.Assert(gaps, qt.HasLen, 0)
+}
+
+func TestProposeMigrationRenames(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{
+		"0001_init.up.sql":   {},
+		"0001_init.down.sql": {},
+		"2_users.UP.SQL":     {},
+		"3_posts.sql":        {},
+		"README.md":          {},
+		"notes.sql":          {},
+	}
+	useMigrationFS(t, fsys)
+	renames, err := ProposeMigrationRenames("/migrations")
+	c.Assert(err, qt.IsNil)
+	c.Assert(renames, qt.DeepEquals, []MigrationRename{
+		{Old: "2_users.UP.SQL", New: "0002_users.up.sql"},
+		{Old: "3_posts.sql", New: "0003_posts.up.sql"},
+	})
+
+	// Without zero-padding, numbers are left as they are.
+	renames, err = canonicalMigrationNames(fstest.MapFS{
+		"1_init.up.sql":   {},
+		"10_users.Up.sql": {},
+	}, ".", migrationOptions{})
+	c.Assert(err, qt.IsNil)
+	c.Assert(renames, qt.DeepEquals, []MigrationRename{
+		{Old: "10_users.Up.sql", New: "10_users.up.sql"},
+	})
+	renames, err = canonicalMigrationNames(fstest.MapFS{
+		"1_UP_posts.SQL": {},
+	}, ".", migrationOptions{Naming: NamingDirectionFirst})
+	c.Assert(err, qt.IsNil)
+	c.Assert(renames, qt.DeepEquals, []MigrationRename{{Old: "1_UP_posts.SQL", New: "1_up_posts.sql"}})
+
+	// Renames never overwrite other files.
+	_, err = canonicalMigrationNames(fstest.MapFS{
+		"01_init.up.sql": {},
+		"1_init.UP.sql":  {},
+	}, ".", migrationOptions{})
+	c.Assert(err, qt.ErrorMatches, `cannot rename 1_init.UP.sql to 01_init.up.sql: 01_init.up.sql already exists`)
+
+	root := t.TempDir()
+	c.Assert(os.WriteFile(filepath.Join(root, "1_a.UP.sql"), nil, 0644), qt.IsNil)
+	c.Assert(ApplyMigrationRenames(paths.RootedFSPath(root, "."), []MigrationRename{{Old: "1_a.UP.sql", New: "1_a.up.sql"}}), qt.IsNil)
+	_, err = os.Stat(filepath.Join(root, "1_a.up.sql"))
+	c.Assert(err, qt.IsNil)
+}
+
+func TestReservedNames(t *testing.T) {
+	c := qt.New(t)
+	fsys := fstest.MapFS{
+		"1_ok.up.sql": {Data: []byte(`CREATE TABLE users (id BIGINT);
+-- DROP TABLE schema_migrations;
+INSERT INTO users (id) SELECT version FROM schema_migrations;
+CREATE TABLE other.schema_migrations (version BIGINT);`)},
+		"2_bad.up.sql": {Data: []byte(`CREATE TABLE IF NOT EXISTS public.schema_migrations (version BIGINT);
+UPDATE "schema_migrations" SET dirty = false;
+DROP TABLE users, Schema_Migrations;
+CREATE UNIQUE INDEX idx ON schema_migrations (version);
+CREATE SCHEMA encore;
+CREATE VIEW encore.v AS SELECT 1;`)},
+	}
+	issues, err := reservedNameIssues(fsys, ".", []MigrationFile{{Filename: "1_ok.up.sql"}, {Filename: "2_bad.up.sql"}})
+	c.Assert(err, qt.IsNil)
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.String())
+	}
+	const suffix = ", which may conflict with Encore's internals"
+	const table = "the reserved table schema_migrations (Encore's record of the applied migrations)" + suffix
+	const schema = "the reserved schema encore (reserved for Encore's internal use)" + suffix
+	c.Assert(got, qt.DeepEquals, []string{
+		"db migration 2_bad.up.sql:1: contains CREATE TABLE targeting " + table,
+		"db migration 2_bad.up.sql:2: contains UPDATE targeting " + table,
+		"db migration 2_bad.up.sql:3: contains DROP TABLE targeting " + table,
+		"db migration 2_bad.up.sql:4: contains CREATE INDEX targeting " + table,
+		"db migration 2_bad.up.sql:5: contains CREATE SCHEMA targeting " + schema,
+		"db migration 2_bad.up.sql:6: contains CREATE VIEW targeting " + schema,
+	})
+}
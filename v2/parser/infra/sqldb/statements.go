@@ -0,0 +1,157 @@
+package sqldb
+
+import (
+	"strings"
+)
+
+// StatementSplitting controls how migrations are split into statements.
+type StatementSplitting int
+
+const (
+	// SplitStatements splits on semicolons outside of string literals, quoted identifiers,
+	// comments and dollar-quoted strings, so function bodies aren't split. It's the default.
+	SplitStatements StatementSplitting = iota
+
+	// SplitSemicolons splits on every semicolon, like some Postgres tools do.
+	SplitSemicolons
+
+	// SplitNone treats the whole migration as a single statement.
+	SplitNone
+)
+
+// splitStatements splits the SQL in a migration into statements according to mode.
+// Statements consisting only of whitespace (and, for SplitStatements, comments) are omitted.
+func splitStatements(sql string, mode StatementSplitting) []string {
+	switch mode {
+	case SplitNone:
+		if strings.TrimSpace(sql) == "" {
+			return nil
+		}
+		return []string{sql}
+
+	case SplitSemicolons:
+		var stmts []string
+		for _, s := range strings.Split(sql, ";") {
+			if strings.TrimSpace(s) != "" {
+				stmts = append(stmts, s)
+			}
+		}
+		return stmts
+	}
+
+	var (
+		stmts      []string
+		start      int
+		hasContent bool // whether the current statement has any non-comment content
+	)
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == '-' && strings.HasPrefix(sql[i:], "--"):
+			if end := strings.IndexByte(sql[i:], '\n'); end >= 0 {
+				i += end + 1
+			} else {
+				i = len(sql)
+			}
+			continue
+
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			i = skipBlockComment(sql, i)
+			continue
+
+		case c == '\'' || c == '"':
+			// Escape string constants (E'...') allow backslash escapes.
+			backslash := c == '\'' && i > 0 && (sql[i-1] == 'E' || sql[i-1] == 'e')
+			i = skipQuoted(sql, i, c, backslash)
+			hasContent = true
+			continue
+
+		case c == '$':
+			if tag, ok := dollarTag(sql[i:]); ok {
+				if end := strings.Index(sql[i+len(tag):], tag); end >= 0 {
+					i += len(tag) + end + len(tag)
+				} else {
+					i = len(sql) // unterminated; the rest of the file is quoted
+				}
+				hasContent = true
+				continue
+			}
+
+		case c == ';':
+			if hasContent {
+				stmts = append(stmts, sql[start:i])
+			}
+			start, hasContent = i+1, false
+			i++
+			continue
+		}
+
+		if c != ' ' && c != '\t' && c != '\n' && c != '\r' {
+			hasContent = true
+		}
+		i++
+	}
+	if hasContent {
+		stmts = append(stmts, sql[start:])
+	}
+	return stmts
+}
+
+// skipBlockComment returns the index just past the (possibly nested)
+// block comment starting at sql[i].
+func skipBlockComment(sql string, i int) int {
+	depth := 0
+	for i < len(sql) {
+		switch {
+		case strings.HasPrefix(sql[i:], "/*"):
+			depth++
+			i += 2
+		case strings.HasPrefix(sql[i:], "*/"):
+			depth--
+			i += 2
+			if depth == 0 {
+				return i
+			}
+		default:
+			i++
+		}
+	}
+	return i
+}
+
+// skipQuoted returns the index just past the string literal or quoted identifier
+// starting at sql[i], delimited by quote. Doubled quotes are treated as escapes,
+// as are backslashes if backslash is true.
+func skipQuoted(sql string, i int, quote byte, backslash bool) int {
+	for i++; i < len(sql); i++ {
+		if backslash && sql[i] == '\\' {
+			i++ // skip the escaped character
+			continue
+		}
+		if sql[i] == quote {
+			if i+1 < len(sql) && sql[i+1] == quote {
+				i++ // escaped quote
+				continue
+			}
+			return i + 1
+		}
+	}
+	return i
+}
+
+// dollarTag reports the dollar-quote tag, like "$$" or "$body$", at the start of s.
+func dollarTag(s string) (string, bool) {
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '$':
+			return s[:i+1], true
+		case c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z'):
+		case '0' <= c && c <= '9' && i > 1:
+			// Tags can't start with a digit, which also rules out positional parameters like $1.
+		default:
+			return "", false
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,54 @@
+package sqldb
+
+import (
+	"bytes"
+	"os/exec"
+	"slices"
+
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/parser/resource/resourceparser"
+)
+
+// untrackedMigrations returns the filenames of the migrations in dir that aren't
+// tracked by git, including ignored files, in the order of migrations.
+// Filenames are slash-separated paths relative to dir, which may include
+// subdirectories for recursive migration directories.
+func untrackedMigrations(dir string, migrations []MigrationFile) ([]string, error) {
+	// nosemgrep: go.lang.security.audit.dangerous-exec-command.dangerous-exec-command
+	cmd := exec.Command("git", "ls-files", "--others", "-z", "--", ".")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	others := make(map[string]bool)
+	for _, name := range bytes.Split(out, []byte{0}) {
+		others[string(name)] = true
+	}
+	var untracked []string
+	for _, m := range migrations {
+		if others[m.Filename] {
+			untracked = append(untracked, m.Filename)
+		}
+	}
+	return untracked, nil
+}
+
+// warnUntrackedMigrations logs a warning for each of the database's migrations
+// that isn't tracked by git, if the "tracked" check is enabled.
+// Such files are easily forgotten when committing, making other environments diverge.
+func warnUntrackedMigrations(p *resourceparser.Pass, migrationDir paths.FS, db *Database) {
+	if !slices.Contains(db.ContentChecks, CheckTracked) {
+		return
+	}
+	untracked, err := untrackedMigrations(migrationDir.ToIO(), db.Migrations)
+	if err != nil {
+		p.Log.Warn().Err(err).Str("db", db.Name).Msg("unable to check whether migrations are tracked by git")
+		return
+	}
+	for _, filename := range untracked {
+		p.Log.Warn().Str("db", db.Name).Str("migration", filename).
+			Msgf("db migration %s is not tracked by git; did you forget to add it?", filename)
+	}
+}
@@ -0,0 +1,64 @@
+package sqldb
+
+import (
+	"fmt"
+	"sync"
+)
+
+// MigrationTransform transforms the parsed migrations of a database
+// before the database is registered, for example to filter out or renumber migrations.
+//
+// The returned migrations must be ordered by migration number,
+// and migration numbers must be positive and unique.
+type MigrationTransform func(dbName string, migrations []MigrationFile) ([]MigrationFile, error)
+
+var (
+	transformMu sync.RWMutex
+	transforms  []MigrationTransform
+)
+
+// RegisterMigrationTransform registers a transform to apply to the migrations
+// of every database that is parsed. Transforms are applied in the order they're registered.
+func RegisterMigrationTransform(fn MigrationTransform) {
+	transformMu.Lock()
+	defer transformMu.Unlock()
+	transforms = append(transforms, fn)
+}
+
+// applyTransforms applies the registered transforms to the migrations of the database dbName,
+// and checks that the result is still valid.
+func applyTransforms(dbName string, migrations []MigrationFile) ([]MigrationFile, error) {
+	transformMu.RLock()
+	defer transformMu.RUnlock()
+
+	for i, fn := range transforms {
+		var err error
+		migrations, err = fn(dbName, migrations)
+		if err != nil {
+			return nil, fmt.Errorf("migration transform #%d: %w", i+1, err)
+		}
+		if err := checkMigrationOrder(migrations); err != nil {
+			return nil, fmt.Errorf("migration transform #%d returned invalid migrations: %w", i+1, err)
+		}
+	}
+	return migrations, nil
+}
+
+// checkMigrationOrder checks that the migrations have positive, unique numbers
+// and are ordered by number.
+func checkMigrationOrder(migrations []MigrationFile) error {
+	for i, mig := range migrations {
+		fn, num := mig.Filename, mig.Number
+		if num <= 0 {
+			return &MigrationError{Filename: fn, Kind: MigrationErrInvalidNumber, Actual: num,
+				Detail: fmt.Sprintf("invalid migration number %d", num)}
+		} else if i > 0 && num == migrations[i-1].Number {
+			return &MigrationError{Filename: fn, Kind: MigrationErrDuplicate, Actual: num,
+				Detail: fmt.Sprintf("duplicate migration with number %d", num)}
+		} else if i > 0 && num < migrations[i-1].Number {
+			return &MigrationError{Filename: fn, Kind: MigrationErrOutOfOrder, Expected: migrations[i-1].Number + 1, Actual: num,
+				Detail: "migrations are not ordered by number"}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package sqldb
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"encr.dev/pkg/paths"
+)
+
+// ValidateAll validates the migrations of all the given databases, along with
+// the relationships between them, and returns the problems found, each attributed
+// to a database. It returns nil if there are none.
+//
+// Each database's migrations are re-read from disk and validated as by
+// ReparseMigrations, relative to the main module directory mainModuleDir.
+// Databases without a package aren't parsed from a migration directory,
+// so they're validated only as a group.
+// Across databases, it runs the same checks as the app parser, reporting
+// NameCollisions and UnknownDependencies, and also checks that the
+// dependencies between databases have no cycles.
+func ValidateAll(mainModuleDir paths.FS, dbs []*Database) []error {
+	var errs []error
+	for _, db := range dbs {
+		if db.Pkg == nil {
+			continue
+		}
+		if _, err := ReparseMigrations(mainModuleDir, db); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, c := range NameCollisions(dbs) {
+		tmpl := ErrDuplicateNames
+		if c.LeftoverMigrationDir() {
+			tmpl = ErrDuplicateMigrationDirs
+		}
+		errs = append(errs, fmt.Errorf("database %s: %s", c.DB.Name, tmpl.Summary))
+	}
+	for _, u := range UnknownDependencies(dbs) {
+		errs = append(errs, fmt.Errorf("database %s: %s", u.DB.Name, ErrDatabaseNotFound(u.Dep).Summary))
+	}
+
+	byName := make(map[string]*Database, len(dbs)) // keyed by lowercase name
+	for _, db := range dbs {
+		if key := strings.ToLower(db.Name); byName[key] == nil {
+			byName[key] = db
+		}
+	}
+	for _, cycle := range dependencyCycles(dbs, byName) {
+		errs = append(errs, fmt.Errorf("database %s: dependency cycle: %s", cycle[0], strings.Join(cycle, " -> ")))
+	}
+	return errs
+}
+
+// NameCollision is a database whose name collides with that of a database before it.
+type NameCollision struct {
+	DB       *Database
+	Previous *Database
+}
+
+// LeftoverMigrationDir reports whether the collision is likely a migration
+// directory left behind when moving the database: the databases are defined in
+// different packages, and at least one only by its package's migration directory.
+func (c NameCollision) LeftoverMigrationDir() bool {
+	return c.Previous.Pkg != c.DB.Pkg && (c.DB.AST == nil || c.Previous.AST == nil)
+}
+
+// NameCollisions returns the databases whose names collide with that of a database
+// before them, in order. Names are compared case-insensitively, since names
+// differing only by case are easily mixed up in Postgres, which folds
+// unquoted identifiers to lowercase.
+func NameCollisions(dbs []*Database) []NameCollision {
+	var collisions []NameCollision
+	byName := make(map[string]*Database, len(dbs)) // keyed by lowercase name
+	for _, db := range dbs {
+		key := strings.ToLower(db.Name)
+		if prev, ok := byName[key]; ok {
+			collisions = append(collisions, NameCollision{DB: db, Previous: prev})
+		}
+		byName[key] = db
+	}
+	return collisions
+}
+
+// UnknownDependency is a declared dependency on a database that doesn't exist.
+type UnknownDependency struct {
+	DB  *Database
+	Dep string // the name of the unknown database
+}
+
+// UnknownDependencies returns the dependencies of the databases
+// that don't refer to one of them, compared case-insensitively.
+func UnknownDependencies(dbs []*Database) []UnknownDependency {
+	names := make(map[string]bool, len(dbs))
+	for _, db := range dbs {
+		names[strings.ToLower(db.Name)] = true
+	}
+	var unknown []UnknownDependency
+	for _, db := range dbs {
+		for _, dep := range db.DependsOn {
+			if !names[strings.ToLower(dep)] {
+				unknown = append(unknown, UnknownDependency{DB: db, Dep: dep})
+			}
+		}
+	}
+	return unknown
+}
+
+// dependencyCycles returns the cycles in the dependencies between the databases,
+// each starting and ending with the same database name. Each cycle is reported once.
+func dependencyCycles(dbs []*Database, byName map[string]*Database) [][]string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[*Database]int)
+	var (
+		cycles [][]string
+		stack  []*Database
+		visit  func(db *Database)
+	)
+	visit = func(db *Database) {
+		state[db] = visiting
+		stack = append(stack, db)
+		for _, dep := range db.DependsOn {
+			next, ok := byName[strings.ToLower(dep)]
+			if !ok {
+				continue
+			}
+			switch state[next] {
+			case unvisited:
+				visit(next)
+			case visiting:
+				start := slices.Index(stack, next)
+				var cycle []string
+				for _, d := range stack[start:] {
+					cycle = append(cycle, d.Name)
+				}
+				cycles = append(cycles, append(cycle, next.Name))
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[db] = visited
+	}
+	for _, db := range dbs {
+		if state[db] == unvisited {
+			visit(db)
+		}
+	}
+	return cycles
+}
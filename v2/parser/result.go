@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strings"
 
+	"encr.dev/pkg/errors"
 	"encr.dev/pkg/fns"
 	"encr.dev/pkg/option"
 	"encr.dev/pkg/paths"
@@ -64,6 +65,8 @@ type Result struct {
 	usageByPkg     map[paths.Pkg][]usage.Usage
 }
 
+var _ sqldb.ResourceGraph = (*Result)(nil)
+
 func (d *Result) MainModule() *pkginfo.Module {
 	return d.mainModule
 }
@@ -209,7 +212,15 @@ func (d *Result) initBinds(errs *perr.List, binds []resource.Bind) {
 			switch {
 			case len(ref.Path) > 0 && ref.Path[0].Kind == resource.SQLDatabase:
 				dbName := ref.Path[0].Name
-				errs.Add(sqldb.ErrDatabaseNotFound(dbName).AtGoPos(b.Pos(), token.NoPos))
+				err := sqldb.ErrDatabaseNotFound(dbName)
+				if other, ok := findDatabaseFold(byPath, dbName); ok {
+					// Postgres folds unquoted identifiers to lowercase, making names differing
+					// only by case easy to mix up, so point out the likely intended database.
+					err = err.AtGoPos(b.Pos(), token.NoPos, errors.AsHelp(fmt.Sprintf("did you mean %q? Database names are case-sensitive", other)))
+				} else {
+					err = err.AtGoPos(b.Pos(), token.NoPos)
+				}
+				errs.Add(err)
 			default:
 
 				// NOTE(andre): We could end up here in the future when we support
@@ -264,6 +275,17 @@ func (d *Result) initUsages(errs *perr.List, ur *usage.Resolver, usageExprs []us
 	d.usageByPos = posmap.Build[usage.Usage](allUsages...)
 }
 
+// findDatabaseFold finds the name of a database in byPath
+// whose name is equal to name under Unicode case-folding.
+func findDatabaseFold(byPath map[string]resource.Resource, name string) (string, bool) {
+	for _, r := range byPath {
+		if named, ok := r.(resource.Named); ok && named.Kind() == resource.SQLDatabase && strings.EqualFold(named.ResourceName(), name) {
+			return named.ResourceName(), true
+		}
+	}
+	return "", false
+}
+
 func pathKey(path resource.Path) string {
 	var b strings.Builder
 	for i, e := range path {
@@ -199,6 +199,17 @@ type compileInput struct {
 func (i *BuilderImpl) Compile(ctx context.Context, p builder.CompileParams) (*builder.CompileResult, error) {
 	data := p.Parse.Data.(*data)
 
+	compileOp := p.OpTracker.Add("Compiling application source code", time.Now())
+	res, err := i.compile(data, p)
+	if err != nil {
+		p.OpTracker.Fail(compileOp, err)
+		return nil, err
+	}
+	p.OpTracker.Done(compileOp, 450*time.Millisecond)
+	return res, nil
+}
+
+func (i *BuilderImpl) compile(data *data, p builder.CompileParams) (*builder.CompileResult, error) {
 	input, _ := json.Marshal(compileInput{
 		RuntimeVersion:  version.Version,
 		UseLocalRuntime: p.Build.UseLocalJSRuntime,
@@ -29,6 +29,7 @@ import (
 	"encr.dev/v2/app/legacymeta"
 	"encr.dev/v2/codegen"
 	"encr.dev/v2/codegen/apigen"
+	"encr.dev/v2/codegen/apigen/maingen"
 	"encr.dev/v2/codegen/apigen/userfacinggen"
 	"encr.dev/v2/codegen/cuegen"
 	"encr.dev/v2/codegen/infragen"
@@ -136,6 +137,8 @@ func (BuilderImpl) Compile(ctx context.Context, p builder.CompileParams) (*build
 			AppRevision:       p.Build.Revision,
 			AppUncommitted:    p.Build.UncommittedChanges,
 			ExecScriptMainPkg: p.Build.MainPkg,
+
+			ExecScriptEntrypoint: p.Build.ExecScriptEntrypoint,
 		})
 
 		if pd.pc.Errs.Len() > 0 {
@@ -144,13 +147,28 @@ func (BuilderImpl) Compile(ctx context.Context, p builder.CompileParams) (*build
 		}
 		p.OpTracker.Done(codegenOp, 450*time.Millisecond)
 
+		mainPkg := paths.Pkg(p.Build.MainPkg.GetOrElse("./encore_internal/main"))
+		if p.Build.ExecScriptEntrypoint.Present() {
+			mainPkg = maingen.ExecScriptEntrypointPkg(pd.mainModule)
+		}
+
+		var env []string
+		tempDir := p.Build.TempDir.GetOrElse("")
+		if tempDir != "" {
+			env = append(env, "GOTMPDIR="+tempDir)
+		}
+
 		compileOp := p.OpTracker.Add("Compiling application source code", time.Now())
 		buildResult := build.Build(ctx, &build.Config{
 			Ctx:          pd.pc,
 			Overlays:     gg.Overlays(),
-			MainPkg:      paths.Pkg(p.Build.MainPkg.GetOrElse("./encore_internal/main")),
+			MainPkg:      mainPkg,
 			KeepOutput:   p.Build.KeepOutput,
+			Env:          env,
+			TempDir:      tempDir,
 			StaticConfig: staticConfig,
+			ExtraFlags:   p.Build.GoBuildFlags,
+			Output:       p.Build.GoBuildLog.GetOrElse(nil),
 		})
 
 		output := &builder.GoBuildOutput{ArtifactDir: buildResult.Dir}